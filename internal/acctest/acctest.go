@@ -147,12 +147,14 @@ func protoV5ProviderFactoriesInit(ctx context.Context, providerNames ...string)
 
 	for _, name := range providerNames {
 		factories[name] = func() (tfprotov5.ProviderServer, error) {
-			providerServerFactory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
+			providerServerFactory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
 
 			if err != nil {
 				return nil, err
 			}
 
+			emulatorProvider(primary)
+
 			return providerServerFactory(), nil
 		}
 	}
@@ -186,6 +188,8 @@ func protoV5ProviderFactoriesNamedInit(ctx context.Context, t *testing.T, provid
 			t.Fatal(err)
 		}
 
+		emulatorProvider(p)
+
 		factories[name] = func() (tfprotov5.ProviderServer, error) { //nolint:unparam
 			return providerServerFactory(), nil
 		}
@@ -208,6 +212,8 @@ func protoV5ProviderFactoriesPlusProvidersInit(ctx context.Context, t *testing.T
 			t.Fatal(err)
 		}
 
+		emulatorProvider(p)
+
 		factories[name] = func() (tfprotov5.ProviderServer, error) { //nolint:unparam
 			return providerServerFactory(), nil
 		}
@@ -295,9 +301,16 @@ func ProtoV5FactoriesMultipleRegions(ctx context.Context, t *testing.T, n int) m
 func PreCheck(ctx context.Context, t *testing.T) {
 	t.Helper()
 
+	preCheckEmulator(t)
+
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
+		if isEmulatorEnabled() {
+			configureEmulatorEnv()
+			emulatorProvider(Provider)
+		}
+
 		envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
 
 		if os.Getenv(envvar.AccessKeyId) != "" {
@@ -936,6 +949,8 @@ func thirdRegionPartition() string {
 func PreCheckAlternateAccount(t *testing.T) {
 	t.Helper()
 
+	skipIfEmulator(t, "an emulator provides a single AWS account")
+
 	envvar.SkipIfAllEmpty(t, []string{envvar.AlternateProfile, envvar.AlternateAccessKeyId}, "credentials for running acceptance testing in alternate AWS account")
 
 	if os.Getenv(envvar.AlternateAccessKeyId) != "" {
@@ -946,6 +961,8 @@ func PreCheckAlternateAccount(t *testing.T) {
 func PreCheckThirdAccount(t *testing.T) {
 	t.Helper()
 
+	skipIfEmulator(t, "an emulator provides a single AWS account")
+
 	envvar.SkipIfAllEmpty(t, []string{envvar.ThirdProfile, envvar.ThirdAccessKeyId}, "credentials for running acceptance testing in third AWS account")
 
 	if os.Getenv(envvar.ThirdAccessKeyId) != "" {
@@ -1097,6 +1114,8 @@ func PreCheckInspector2(ctx context.Context, t *testing.T) {
 func PreCheckOrganizationsAccount(ctx context.Context, t *testing.T) {
 	t.Helper()
 
+	skipIfEmulator(t, "AWS Organizations membership cannot be emulated")
+
 	_, err := tforganizations.FindOrganization(ctx, Provider.Meta().(*conns.AWSClient).OrganizationsClient(ctx))
 
 	if tfresource.NotFound(err) {
@@ -1119,6 +1138,8 @@ func PreCheckOrganizationsEnabled(ctx context.Context, t *testing.T) *organizati
 func PreCheckOrganizationsEnabledServicePrincipal(ctx context.Context, t *testing.T, servicePrincipalName string) {
 	t.Helper()
 
+	skipIfEmulator(t, "AWS Organizations membership cannot be emulated")
+
 	servicePrincipalNames, err := tforganizations.FindEnabledServicePrincipalNames(ctx, Provider.Meta().(*conns.AWSClient).OrganizationsClient(ctx))
 
 	if err != nil {
@@ -1133,6 +1154,8 @@ func PreCheckOrganizationsEnabledServicePrincipal(ctx context.Context, t *testin
 func PreCheckOrganizationsEnabledWithProvider(ctx context.Context, t *testing.T, providerF ProviderFunc) *organizationstypes.Organization {
 	t.Helper()
 
+	skipIfEmulator(t, "AWS Organizations membership cannot be emulated")
+
 	organization, err := tforganizations.FindOrganization(ctx, providerF().Meta().(*conns.AWSClient).OrganizationsClient(ctx))
 
 	if tfresource.NotFound(err) {
@@ -1209,6 +1232,8 @@ func PreCheckPinpointApp(ctx context.Context, t *testing.T) {
 func PreCheckRegionOptIn(ctx context.Context, t *testing.T, region string) {
 	t.Helper()
 
+	skipIfEmulator(t, "Region opt-in status cannot be emulated")
+
 	output, err := tfaccount.FindRegionOptStatus(ctx, Provider.Meta().(*conns.AWSClient).AccountClient(ctx), "", region)
 
 	if err != nil {
@@ -1223,6 +1248,8 @@ func PreCheckRegionOptIn(ctx context.Context, t *testing.T, region string) {
 func PreCheckSSOAdminInstances(ctx context.Context, t *testing.T) {
 	t.Helper()
 
+	skipIfEmulator(t, "SSO Instances cannot be emulated")
+
 	conn := Provider.Meta().(*conns.AWSClient).SSOAdminClient(ctx)
 	input := ssoadmin.ListInstancesInput{}
 	var instances []ssoadmintypes.InstanceMetadata
@@ -1345,6 +1372,8 @@ func PreCheckDirectoryServiceSimpleDirectory(ctx context.Context, t *testing.T)
 func PreCheckOutpostsOutposts(ctx context.Context, t *testing.T) {
 	t.Helper()
 
+	skipIfEmulator(t, "Outposts cannot be emulated")
+
 	conn := Provider.Meta().(*conns.AWSClient).OutpostsClient(ctx)
 	input := outposts.ListOutpostsInput{}
 
@@ -1695,6 +1724,8 @@ func PreCheckSkipError(err error) bool {
 func PreCheckAssumeRoleARN(t *testing.T) {
 	t.Helper()
 
+	skipIfEmulator(t, "restricted IAM permissions cannot be emulated")
+
 	envvar.SkipIfEmpty(t, envvar.AccAssumeRoleARN, "Amazon Resource Name (ARN) of existing IAM Role to assume for testing restricted permissions")
 }
 
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+const (
+	// Emulators accept any credentials.
+	emulatorAccessKeyID     = "test"
+	emulatorSecretAccessKey = "test"
+)
+
+type emulatorCompatibleTestMap map[string]bool
+
+func (m emulatorCompatibleTestMap) Lock() {
+	conns.GlobalMutexKV.Lock(m.key())
+}
+
+func (m emulatorCompatibleTestMap) Unlock() {
+	conns.GlobalMutexKV.Unlock(m.key())
+}
+
+func (m emulatorCompatibleTestMap) key() string {
+	return "emulator-compatible-tests"
+}
+
+var (
+	emulatorCompatibleTests = emulatorCompatibleTestMap(make(map[string]bool, 0))
+)
+
+func isEmulatorEnabled() bool {
+	return os.Getenv(envvar.AccEmulatorEndpoint) != ""
+}
+
+// EmulatorCompatible declares that the test, and any subtests, can be run against a local AWS API emulator.
+// When TF_ACC_EMULATOR_ENDPOINT is set, tests that have not declared compatibility are skipped by PreCheck.
+//
+// It must be called before the test case's PreCheck runs, typically at the start of the test function.
+func EmulatorCompatible(t *testing.T) {
+	t.Helper()
+
+	emulatorCompatibleTests.Lock()
+	defer emulatorCompatibleTests.Unlock()
+
+	emulatorCompatibleTests[t.Name()] = true
+}
+
+// isEmulatorCompatible returns whether the test, or any of its parent tests, has declared emulator compatibility.
+func isEmulatorCompatible(t *testing.T) bool {
+	t.Helper()
+
+	emulatorCompatibleTests.Lock()
+	defer emulatorCompatibleTests.Unlock()
+
+	for name := t.Name(); ; {
+		if emulatorCompatibleTests[name] {
+			return true
+		}
+
+		i := strings.LastIndex(name, "/")
+		if i < 0 {
+			return false
+		}
+		name = name[:i]
+	}
+}
+
+// preCheckEmulator skips the test if it is being run against an emulator but has not declared emulator compatibility.
+func preCheckEmulator(t *testing.T) {
+	t.Helper()
+
+	if isEmulatorEnabled() && !isEmulatorCompatible(t) {
+		t.Skipf("skipping test; %s is set and the test is not emulator compatible", envvar.AccEmulatorEndpoint)
+	}
+}
+
+// skipIfEmulator skips the test if it is being run against an emulator.
+// It is used by PreChecks for account features that an emulator cannot provide.
+func skipIfEmulator(t *testing.T, reason string) {
+	t.Helper()
+
+	if isEmulatorEnabled() {
+		t.Skipf("skipping test; %s is set and %s", envvar.AccEmulatorEndpoint, reason)
+	}
+}
+
+// configureEmulatorEnv points all AWS API calls at the emulator and,
+// if no credentials are configured, sets credentials accepted by the emulator.
+func configureEmulatorEnv() {
+	os.Setenv(envvar.EndpointURL, os.Getenv(envvar.AccEmulatorEndpoint))
+
+	if os.Getenv(envvar.Profile) == "" && os.Getenv(envvar.AccessKeyId) == "" && os.Getenv(envvar.ContainerCredentialsFullURI) == "" {
+		os.Setenv(envvar.AccessKeyId, emulatorAccessKeyID)
+		os.Setenv(envvar.SecretAccessKey, emulatorSecretAccessKey)
+	}
+}
+
+// emulatorProvider configures the provider for use with an emulator, if enabled.
+func emulatorProvider(provider *schema.Provider) *schema.Provider {
+	if isEmulatorEnabled() {
+		provider.ConfigureContextFunc = emulatorProviderConfigureContextFunc(provider.ConfigureContextFunc)
+	}
+
+	return provider
+}
+
+// emulatorProviderConfigureContextFunc returns a provider configuration function that overrides
+// provider configuration that cannot be used with an emulator.
+func emulatorProviderConfigureContextFunc(configureContextFunc schema.ConfigureContextFunc) schema.ConfigureContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		// Emulators are addressed by host and port, so S3 virtual hosted-style addressing can't be used.
+		if err := d.Set("s3_use_path_style", true); err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		return configureContextFunc(ctx, d)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+func TestEmulatorCompatible(t *testing.T) {
+	t.Parallel()
+
+	t.Run("compatible", func(t *testing.T) {
+		acctest.EmulatorCompatible(t)
+
+		if !acctest.IsEmulatorCompatible(t) {
+			t.Error("expected test to be emulator compatible")
+		}
+
+		t.Run("subtest", func(t *testing.T) {
+			if !acctest.IsEmulatorCompatible(t) {
+				t.Error("expected subtest to be emulator compatible")
+			}
+		})
+	})
+
+	t.Run("not compatible", func(t *testing.T) {
+		if acctest.IsEmulatorCompatible(t) {
+			t.Error("expected test not to be emulator compatible")
+		}
+	})
+}
+
+func TestEmulatorProvider(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	t.Setenv(envvar.AccEmulatorEndpoint, "http://localhost:4566")
+
+	p, err := provider.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	acctest.EmulatorProvider(p)
+
+	diags := p.Configure(ctx, terraform.NewResourceConfigRaw(map[string]any{
+		"access_key":                  "test",
+		"region":                      "us-west-2", //lintignore:AWSAT003
+		"secret_key":                  "test",
+		"skip_credentials_validation": true,
+		"skip_metadata_api_check":     "true",
+		"skip_requesting_account_id":  true,
+	}))
+	if err := sdkdiag.DiagnosticsError(diags); err != nil {
+		t.Fatalf("configuring provider: %s", err)
+	}
+
+	if !p.Meta().(*conns.AWSClient).S3UsePathStyle(ctx) {
+		t.Error("expected S3 path-style addressing to be enabled")
+	}
+}
@@ -5,5 +5,7 @@ package acctest
 
 // Exports for use in tests only.
 var (
-	CloseVCRRecorder = closeVCRRecorder
+	CloseVCRRecorder     = closeVCRRecorder
+	EmulatorProvider     = emulatorProvider
+	IsEmulatorCompatible = isEmulatorCompatible
 )
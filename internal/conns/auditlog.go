@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/aws-sdk-go-base/v2/logging"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+const (
+	// Environment variable specifying the path of the API call audit log.
+	// The value of the `audit_log_path` provider argument takes precedence.
+	AuditLogPathEnvVar = "TF_AWS_AUDIT_LOG_PATH"
+)
+
+// auditLogEntry is a single line of the API call audit log.
+type auditLogEntry struct {
+	Time         time.Time `json:"time"`
+	Service      string    `json:"service"`
+	Operation    string    `json:"operation"`
+	Region       string    `json:"region"`
+	AccountID    string    `json:"account_id"`
+	ResourceType string    `json:"resource_type,omitempty"`
+	RequestID    string    `json:"request_id,omitempty"`
+	LatencyMS    int64     `json:"latency_ms"`
+	RetryCount   int       `json:"retry_count"`
+	ErrorCode    string    `json:"error_code,omitempty"`
+	ErrorMessage string    `json:"error_message,omitempty"`
+}
+
+// auditLogger writes a JSON Lines audit trail of AWS SDK for Go v2 API calls.
+type auditLogger struct {
+	accountID string
+	writer    *auditLogWriter
+}
+
+// auditLogWriter serializes writes to an audit log.
+type auditLogWriter struct {
+	lock sync.Mutex
+	w    io.Writer
+}
+
+var (
+	auditLogWritersLock sync.Mutex
+	auditLogWriters     = make(map[string]*auditLogWriter) // Path -> writer.
+)
+
+// openAuditLogWriter returns the writer for the audit log at the specified path.
+// Writers are shared by all provider instances in the process.
+func openAuditLogWriter(path string) (*auditLogWriter, error) {
+	auditLogWritersLock.Lock()
+	defer auditLogWritersLock.Unlock()
+
+	if w, ok := auditLogWriters[path]; ok {
+		return w, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &auditLogWriter{w: f}
+	auditLogWriters[path] = w
+
+	return w, nil
+}
+
+func (w *auditLogWriter) write(entry *auditLogEntry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	_, err = w.w.Write(b)
+
+	return err
+}
+
+// addMiddleware adds the audit log middleware to an API client's middleware stack.
+// Add to `aws.Config.APIOptions`.
+func (l *auditLogger) addMiddleware(stack *middleware.Stack) error {
+	// Add at the end of the Initialize step so that service metadata is available and latency includes any retries.
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TFAuditLog", l.handleInitialize), middleware.After)
+}
+
+func (l *auditLogger) handleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	start := time.Now()
+
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	entry := auditLogEntry{
+		Time:      start.UTC(),
+		Service:   awsmiddleware.GetServiceID(ctx),
+		Operation: awsmiddleware.GetOperationName(ctx),
+		Region:    awsmiddleware.GetRegion(ctx),
+		AccountID: l.accountID,
+		LatencyMS: time.Since(start).Milliseconds(),
+	}
+
+	if inContext, ok := FromContext(ctx); ok {
+		entry.ResourceType = inContext.TypeName()
+	}
+
+	if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+		entry.RequestID = v
+	}
+
+	if v, ok := retry.GetAttemptResults(metadata); ok && len(v.Results) > 1 {
+		entry.RetryCount = len(v.Results) - 1
+	}
+
+	if err != nil {
+		if entry.RequestID == "" {
+			if v, ok := errs.As[interface {
+				error
+				ServiceRequestID() string
+			}](err); ok {
+				entry.RequestID = v.ServiceRequestID()
+			}
+		}
+
+		if apiErr, ok := errs.As[smithy.APIError](err); ok {
+			entry.ErrorCode = apiErr.ErrorCode()
+			entry.ErrorMessage = redactAuditLogValue(apiErr.ErrorMessage())
+		} else {
+			entry.ErrorMessage = redactAuditLogValue(err.Error())
+		}
+	}
+
+	if err := l.writer.write(&entry); err != nil {
+		tflog.Warn(ctx, "writing audit log entry", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	return out, metadata, err
+}
+
+// redactAuditLogValue masks any AWS access key IDs and secret access keys in the specified value.
+func redactAuditLogValue(v string) string {
+	b := logging.MaskAWSAccessKey([]byte(v))
+	logging.MaskAWSSecretKeys(b)
+
+	return string(b)
+}
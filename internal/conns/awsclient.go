@@ -10,6 +10,7 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -30,7 +31,7 @@ import (
 type AWSClient struct {
 	accountID                 string
 	awsConfig                 *aws.Config
-	clients                   map[string]any
+	clients                   map[string]map[string]any // Region -> service package name -> API client.
 	defaultTagsConfig         *tftags.DefaultConfig
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
@@ -38,13 +39,15 @@ type AWSClient struct {
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
+	rateLimiters              map[string]*serviceRateLimiter // Service package name -> rate limiter.
 	region                    string
 	servicePackages           map[string]ServicePackage
 	session                   *session_sdkv1.Session
-	s3ExpressClient           *s3.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	s3ExpressClients          map[string]*s3.Client // Region -> API client.
+	s3UsePathStyle            bool                  // From provider configuration.
+	s3USEast1RegionalEndpoint string                // From provider configuration.
+	stsRegion                 string                // From provider configuration.
+	tagPolicyConfig           *tftags.PolicyConfig
 }
 
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
@@ -79,8 +82,16 @@ func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
 
-func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
-	return c.awsConfig.Copy()
+func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.PolicyConfig {
+	return c.tagPolicyConfig
+}
+
+// AwsConfig returns a copy of the AWS SDK for Go v2 configuration.
+// The configuration's Region is any per-resource Region override in effect.
+func (c *AWSClient) AwsConfig(ctx context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
+	cfg := c.awsConfig.Copy()
+	cfg.Region = c.Region(ctx)
+	return cfg
 }
 
 // AwsSession and Endpoints can be removed once the simpledb service is removed.
@@ -103,10 +114,36 @@ func (c *AWSClient) Partition(context.Context) string {
 }
 
 // Region returns the ID of the configured AWS Region.
-func (c *AWSClient) Region(context.Context) string {
+// If a per-resource Region override is in effect, the override value is returned.
+func (c *AWSClient) Region(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok {
+		if v := inContext.OverrideRegion(); v != "" {
+			return v
+		}
+	}
+
+	return c.region
+}
+
+// DefaultRegion returns the ID of the provider-configured AWS Region,
+// ignoring any per-resource Region override.
+func (c *AWSClient) DefaultRegion(context.Context) string {
 	return c.region
 }
 
+// ValidateInContextRegionInPartition verifies that any per-resource Region override is in the configured AWS partition.
+func (c *AWSClient) ValidateInContextRegionInPartition(ctx context.Context) error {
+	if inContext, ok := FromContext(ctx); ok {
+		if region := inContext.OverrideRegion(); region != "" {
+			if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); !ok || partition.ID() != c.Partition(ctx) {
+				return fmt.Errorf("the region %q is not valid for the configured partition %q", region, c.Partition(ctx))
+			}
+		}
+	}
+
+	return nil
+}
+
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
 // e.g. PREFIX.amazonaws.com
 // The prefix should not contain a trailing period.
@@ -167,22 +204,29 @@ func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string
 // In that case the returned client uses the regional S3 endpoint.
 func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
 	s3Client := c.S3Client(ctx)
+	region := c.Region(ctx)
 
 	c.lock.Lock() // OK since a non-default client is created.
 	defer c.lock.Unlock()
 
-	if c.s3ExpressClient == nil {
+	s3ExpressClient, ok := c.s3ExpressClients[region]
+	if !ok {
 		if s3Client.Options().Region == endpoints.AwsGlobalRegionID {
 			// No global endpoint for S3 Express.
-			c.s3ExpressClient = errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
+			s3ExpressClient = errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
 				"s3_us_east_1_regional_endpoint": "regional",
 			}))
 		} else {
-			c.s3ExpressClient = s3Client
+			s3ExpressClient = s3Client
+		}
+
+		if c.s3ExpressClients == nil {
+			c.s3ExpressClients = make(map[string]*s3.Client)
 		}
+		c.s3ExpressClients[region] = s3ExpressClient
 	}
 
-	return c.s3ExpressClient
+	return s3ExpressClient
 }
 
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
@@ -316,8 +360,20 @@ func convertIPToDashIP(ip string) string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig := c.awsConfig
+	if region := c.Region(ctx); region != awsConfig.Region {
+		cfg := awsConfig.Copy()
+		cfg.Region = region
+		awsConfig = &cfg
+	}
+	if rateLimiter, ok := c.rateLimiters[servicePackageName]; ok {
+		cfg := awsConfig.Copy()
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), rateLimiter.addMiddleware)
+		awsConfig = &cfg
+	}
+
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 	}
@@ -338,18 +394,19 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 }
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) is cached per-Region. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
 	isDefault := len(extra) == 0
+	region := c.Region(ctx)
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw, ok := c.clients[region][servicePackageName]; ok {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -384,7 +441,10 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		if _, ok := c.clients[region]; !ok {
+			c.clients[region] = make(map[string]any)
+		}
+		c.clients[region][servicePackageName] = client
 	}
 
 	return client, nil
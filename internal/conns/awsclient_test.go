@@ -184,3 +184,103 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestAWSClientRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	awsClient := &AWSClient{
+		partition: standardPartition,
+		region:    "us-west-2", //lintignore:AWSAT003
+	}
+	testCases := []struct {
+		Name     string
+		Context  context.Context
+		Expected string
+	}{
+		{
+			Name:     "no override",
+			Context:  context.TODO(),
+			Expected: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name:     "empty override",
+			Context:  NewResourceContext(context.TODO(), "Test", "aws_test", "aws_test", ""),
+			Expected: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name:     "override",
+			Context:  NewResourceContext(context.TODO(), "Test", "aws_test", "aws_test", "eu-west-1"), //lintignore:AWSAT003
+			Expected: "eu-west-1",                                                                     //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, expected := awsClient.Region(testCase.Context), testCase.Expected; got != expected {
+				t.Errorf("Region: got %s, expected %s", got, expected)
+			}
+			if got, expected := awsClient.DefaultRegion(testCase.Context), "us-west-2"; got != expected { //lintignore:AWSAT003
+				t.Errorf("DefaultRegion: got %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestAWSClientValidateInContextRegionInPartition(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		AWSClient     *AWSClient
+		Context       context.Context
+		ExpectedError bool
+	}{
+		{
+			Name: "no override",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+				region:    "us-west-2", //lintignore:AWSAT003
+			},
+			Context: context.TODO(),
+		},
+		{
+			Name: "override in partition",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+				region:    "us-west-2", //lintignore:AWSAT003
+			},
+			Context: NewResourceContext(context.TODO(), "Test", "aws_test", "aws_test", "eu-west-1"), //lintignore:AWSAT003
+		},
+		{
+			Name: "override not in partition",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+				region:    "us-west-2", //lintignore:AWSAT003
+			},
+			Context:       NewResourceContext(context.TODO(), "Test", "aws_test", "aws_test", "cn-north-1"), //lintignore:AWSAT003
+			ExpectedError: true,
+		},
+		{
+			Name: "override in China partition",
+			AWSClient: &AWSClient{
+				partition: chinaPartition,
+				region:    "cn-north-1", //lintignore:AWSAT003
+			},
+			Context: NewResourceContext(context.TODO(), "Test", "aws_test", "aws_test", "cn-northwest-1"), //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.AWSClient.ValidateInContextRegionInPartition(testCase.Context)
+
+			if got, expected := err != nil, testCase.ExpectedError; got != expected {
+				t.Errorf("got error %v, expected error %t", err, expected)
+			}
+		})
+	}
+}
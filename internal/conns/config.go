@@ -11,6 +11,8 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	organizationstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
 	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
@@ -31,6 +33,7 @@ type Config struct {
 	AllowedAccountIds              []string
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	AuditLogPath                   string
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
@@ -45,6 +48,7 @@ type Config struct {
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	RateLimits                     []RateLimit
 	Region                         string
 	RetryMode                      aws.RetryMode
 	S3UsePathStyle                 bool
@@ -57,6 +61,7 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
@@ -207,6 +212,24 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		}
 	}
 
+	if c.AuditLogPath != "" {
+		w, err := openAuditLogWriter(c.AuditLogPath)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "opening audit log (%s): %s", c.AuditLogPath, err)
+		}
+
+		auditLog := &auditLogger{
+			accountID: accountID,
+			writer:    w,
+		}
+		cfg.APIOptions = append(cfg.APIOptions, auditLog.addMiddleware)
+	}
+
+	rateLimiters, err := newServiceRateLimiters(c.RateLimits)
+	if err != nil {
+		return nil, sdkdiag.AppendErrorf(diags, "configuring rate limits: %s", err)
+	}
+
 	client.accountID = accountID
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
@@ -216,16 +239,47 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
-	client.clients = make(map[string]any, 0)
+	client.clients = make(map[string]map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.rateLimiters = rateLimiters
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
+	client.tagPolicyConfig = c.TagPolicyConfig
+
+	if v := c.TagPolicyConfig; v != nil && v.UseEffectivePolicy {
+		tflog.Debug(ctx, "Retrieving effective AWS Organizations tag policy")
+		if err := mergeEffectiveTagPolicy(ctx, client, v); err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "retrieving effective tag policy: %s", err)
+		}
+	}
 
 	return client, diags
 }
 
+// mergeEffectiveTagPolicy merges the account's effective AWS Organizations tag policy, if any, into the specified configuration.
+func mergeEffectiveTagPolicy(ctx context.Context, client *AWSClient, policyConfig *tftags.PolicyConfig) error {
+	input := organizations.DescribeEffectivePolicyInput{
+		PolicyType: organizationstypes.EffectivePolicyTypeTagPolicy,
+	}
+	output, err := client.OrganizationsClient(ctx).DescribeEffectivePolicy(ctx, &input)
+
+	if errs.IsA[*organizationstypes.EffectivePolicyNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if output == nil || output.EffectivePolicy == nil {
+		return nil
+	}
+
+	return policyConfig.MergeEffectivePolicy(aws.ToString(output.EffectivePolicy.PolicyContent))
+}
+
 func baseSeverityToSDKSeverity(s basediag.Severity) diag.Severity {
 	switch s {
 	case basediag.SeverityWarning:
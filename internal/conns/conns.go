@@ -26,6 +26,13 @@ type ServicePackageWithEphemeralResources interface {
 	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
 }
 
+// ServicePackageWithSDKListResources is an interface that extends ServicePackage with list resources for Plugin SDK resources.
+// List resources are used by `terraform query` to discover existing resources.
+type ServicePackageWithSDKListResources interface {
+	ServicePackage
+	SDKListResources(context.Context) []*types.ServicePackageSDKListResource
+}
+
 type (
 	contextKeyType int
 )
@@ -38,8 +45,10 @@ var (
 type InContext struct {
 	isDataSource        bool   // Data source?
 	isEphemeralResource bool   // Ephemeral resource?
+	overrideRegion      string // Any currently in effect per-resource Region override.
 	resourceName        string // Friendly resource name, e.g. "Subnet"
 	servicePackageName  string // Canonical name defined as a constant in names package
+	typeName            string // Terraform type name, e.g. "aws_subnet"
 }
 
 // IsDataSource returns true if the resource is a data source.
@@ -52,6 +61,11 @@ func (c *InContext) IsEphemeralResource() bool {
 	return c.isEphemeralResource
 }
 
+// OverrideRegion returns any currently in effect per-resource Region override.
+func (c *InContext) OverrideRegion() string {
+	return c.overrideRegion
+}
+
 // ResourceName returns the friendly resource name, e.g. "Subnet".
 func (c *InContext) ResourceName() string {
 	return c.resourceName
@@ -62,30 +76,41 @@ func (c *InContext) ServicePackageName() string {
 	return c.servicePackageName
 }
 
-func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+// TypeName returns the Terraform type name, e.g. "aws_subnet".
+func (c *InContext) TypeName() string {
+	return c.typeName
+}
+
+func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName, typeName, overrideRegion string) context.Context {
 	v := InContext{
 		isDataSource:       true,
+		overrideRegion:     overrideRegion,
 		resourceName:       resourceName,
 		servicePackageName: servicePackageName,
+		typeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName, typeName, overrideRegion string) context.Context {
 	v := InContext{
 		isEphemeralResource: true,
+		overrideRegion:      overrideRegion,
 		resourceName:        resourceName,
 		servicePackageName:  servicePackageName,
+		typeName:            typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, typeName, overrideRegion string) context.Context {
 	v := InContext{
+		overrideRegion:     overrideRegion,
 		resourceName:       resourceName,
 		servicePackageName: servicePackageName,
+		typeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
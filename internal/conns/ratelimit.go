@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RateLimit contains client-side rate limiting settings for a service or for a single operation of a service.
+type RateLimit struct {
+	// Service is the service package name, e.g. "route53".
+	Service string
+	// Operation is the API operation name, e.g. "ChangeResourceRecordSets".
+	// If empty, the limits apply to all of the service's operations.
+	Operation string
+	// RequestsPerSecond is the maximum sustained rate at which requests are sent. Zero means unlimited.
+	RequestsPerSecond float64
+	// MaxInFlight is the maximum number of concurrent requests. Zero means unlimited.
+	MaxInFlight int
+}
+
+// rateLimiter paces requests and caps the number of requests in flight.
+// The zero value does not limit.
+type rateLimiter struct {
+	interval time.Duration // Minimum time between request starts.
+	lock     sync.Mutex
+	next     time.Time     // Earliest time at which the next request may start.
+	slots    chan struct{} // In-flight request semaphore.
+}
+
+func newRateLimiter(requestsPerSecond float64, maxInFlight int) *rateLimiter {
+	l := &rateLimiter{}
+
+	if requestsPerSecond > 0 {
+		l.interval = time.Duration(float64(time.Second) / requestsPerSecond)
+	}
+
+	if maxInFlight > 0 {
+		l.slots = make(chan struct{}, maxInFlight)
+	}
+
+	return l
+}
+
+// acquire blocks until a request may be sent or the context is done.
+// It returns the time spent blocked, which is zero if the request was not delayed.
+// On success, the caller must call release once the request completes.
+func (l *rateLimiter) acquire(ctx context.Context) (time.Duration, error) {
+	start := time.Now()
+	var blocked bool
+
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		default:
+			blocked = true
+			select {
+			case l.slots <- struct{}{}:
+			case <-ctx.Done():
+				return time.Since(start), context.Cause(ctx)
+			}
+		}
+	}
+
+	if l.interval > 0 {
+		l.lock.Lock()
+		now := time.Now()
+		if l.next.Before(now) {
+			l.next = now
+		}
+		delay := l.next.Sub(now)
+		l.next = l.next.Add(l.interval)
+		l.lock.Unlock()
+
+		if delay > 0 {
+			blocked = true
+			timer := time.NewTimer(delay)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				l.release()
+				return time.Since(start), context.Cause(ctx)
+			}
+		}
+	}
+
+	if !blocked {
+		return 0, nil
+	}
+
+	return time.Since(start), nil
+}
+
+func (l *rateLimiter) release() {
+	if l.slots != nil {
+		<-l.slots
+	}
+}
+
+// serviceRateLimiter applies the rate limits configured for a single service.
+type serviceRateLimiter struct {
+	service    string
+	all        *rateLimiter            // Limits applying to all operations.
+	operations map[string]*rateLimiter // Operation name -> limits.
+}
+
+// newServiceRateLimiters returns the rate limiters for the specified configuration, indexed by service package name.
+func newServiceRateLimiters(rateLimits []RateLimit) (map[string]*serviceRateLimiter, error) {
+	limiters := make(map[string]*serviceRateLimiter)
+
+	for _, v := range rateLimits {
+		if v.RequestsPerSecond <= 0 && v.MaxInFlight <= 0 {
+			continue
+		}
+
+		l, ok := limiters[v.Service]
+		if !ok {
+			l = &serviceRateLimiter{
+				service:    v.Service,
+				operations: make(map[string]*rateLimiter),
+			}
+			limiters[v.Service] = l
+		}
+
+		if v.Operation == "" {
+			if l.all != nil {
+				return nil, fmt.Errorf("duplicate rate limit for service %q", v.Service)
+			}
+			l.all = newRateLimiter(v.RequestsPerSecond, v.MaxInFlight)
+		} else {
+			if _, ok := l.operations[v.Operation]; ok {
+				return nil, fmt.Errorf("duplicate rate limit for service %q operation %q", v.Service, v.Operation)
+			}
+			l.operations[v.Operation] = newRateLimiter(v.RequestsPerSecond, v.MaxInFlight)
+		}
+	}
+
+	return limiters, nil
+}
+
+// addMiddleware adds the rate limiting middleware to an API client's middleware stack.
+// Add to `aws.Config.APIOptions`.
+func (l *serviceRateLimiter) addMiddleware(stack *middleware.Stack) error {
+	m := middleware.FinalizeMiddlewareFunc("TFRateLimit", l.handleFinalize)
+
+	// Insert after the retry middleware so that each attempt is limited and retry backoff does not hold an in-flight slot.
+	if err := stack.Finalize.Insert(m, "Retry", middleware.After); err == nil {
+		return nil
+	}
+
+	return stack.Finalize.Add(m, middleware.Before)
+}
+
+func (l *serviceRateLimiter) handleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	operation := awsmiddleware.GetOperationName(ctx)
+
+	// Acquire the narrower limit first so that a request waiting on its operation's limit does not hold a service-wide slot.
+	var limiters []*rateLimiter
+	if v, ok := l.operations[operation]; ok {
+		limiters = append(limiters, v)
+	}
+	if l.all != nil {
+		limiters = append(limiters, l.all)
+	}
+
+	var wait time.Duration
+	for i, limiter := range limiters {
+		d, err := limiter.acquire(ctx)
+		wait += d
+		if err != nil {
+			for _, limiter := range limiters[:i] {
+				limiter.release()
+			}
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, fmt.Errorf("waiting for %s %s rate limit: %w", l.service, operation, err)
+		}
+	}
+	defer func() {
+		for _, limiter := range limiters {
+			limiter.release()
+		}
+	}()
+
+	if wait > 0 {
+		tflog.Debug(ctx, "rate limited AWS API call", map[string]any{
+			"tf_aws.rate_limit.service":   l.service,
+			"tf_aws.rate_limit.operation": operation,
+			"tf_aws.rate_limit.wait_ms":   wait.Milliseconds(),
+		})
+	}
+
+	return next.HandleFinalize(ctx, in)
+}
@@ -24,6 +24,9 @@ const (
 	// Default AWS region for tests (AWS Go SDK does not provide this as constant)
 	DefaultRegion = "AWS_DEFAULT_REGION"
 
+	// Base endpoint URL for all AWS API calls (AWS Go SDK does not provide this as constant)
+	EndpointURL = "AWS_ENDPOINT_URL"
+
 	// Default AWS shared configuration profile for tests (AWS Go SDK does not provide this as constant)
 	Profile = "AWS_PROFILE"
 
@@ -66,6 +69,10 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	AccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For tests run against a local AWS API emulator instead of AWS, the emulator's endpoint URL
+	// Only tests declaring emulator compatibility are run
+	AccEmulatorEndpoint = "TF_ACC_EMULATOR_ENDPOINT"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
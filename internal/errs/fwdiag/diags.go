@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
 // DiagnosticsError returns an error containing all Diagnostic with SeverityError
@@ -59,3 +60,19 @@ func DiagnosticsString(diags diag.Diagnostics) string {
 
 	return buf.String()
 }
+
+// FromSDKDiagnostics converts Plugin SDK v2 Diagnostics to Plugin Framework Diagnostics.
+func FromSDKDiagnostics(sdkDiags sdkdiag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, d := range sdkDiags {
+		switch d.Severity {
+		case sdkdiag.Error:
+			diags.AddError(d.Summary, d.Detail)
+		case sdkdiag.Warning:
+			diags.AddWarning(d.Summary, d.Detail)
+		}
+	}
+
+	return diags
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 )
 
@@ -68,3 +69,47 @@ func TestDiagnosticsError(t *testing.T) {
 		})
 	}
 }
+
+func TestFromSDKDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName     string
+		sdkDiags     sdkdiag.Diagnostics
+		wantErrors   int
+		wantWarnings int
+	}{
+		{
+			testName: "nil Diagnostics",
+		},
+		{
+			testName:     "single warning Diagnostics",
+			sdkDiags:     sdkdiag.Diagnostics{{Severity: sdkdiag.Warning, Summary: "summary", Detail: "detail"}},
+			wantWarnings: 1,
+		},
+		{
+			testName: "mixed warning and error Diagnostics",
+			sdkDiags: sdkdiag.Diagnostics{
+				{Severity: sdkdiag.Warning, Summary: "summary1", Detail: "detail1"},
+				{Severity: sdkdiag.Error, Summary: "summary2", Detail: "detail2"},
+			},
+			wantErrors:   1,
+			wantWarnings: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			diags := fwdiag.FromSDKDiagnostics(testCase.sdkDiags)
+
+			if got, want := diags.ErrorsCount(), testCase.wantErrors; got != want {
+				t.Errorf("ErrorsCount = %d, want = %d", got, want)
+			}
+			if got, want := diags.WarningsCount(), testCase.wantWarnings; got != want {
+				t.Errorf("WarningsCount = %d, want = %d", got, want)
+			}
+		})
+	}
+}
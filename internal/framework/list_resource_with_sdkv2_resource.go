@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ListResourceWithSDKv2Resource is a structure to be embedded within a list resource that lists instances of a Plugin SDK v2 resource.
+// The list resource's results have the same schemas as the Plugin SDK v2 resource.
+type ListResourceWithSDKv2Resource struct {
+	withMeta
+	resourceSchema *schema.Resource
+}
+
+// Metadata should return the full name of the list resource, such as
+// examplecloud_thing.
+func (*ListResourceWithSDKv2Resource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	// This method is implemented in the wrappers.
+	panic("not implemented") // lintignore:R009
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined ListResource type.
+func (l *ListResourceWithSDKv2Resource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		l.meta = v
+	}
+}
+
+// SetResourceSchema sets the Plugin SDK v2 resource whose instances are listed.
+// The resource must be the fully registered (wrapped) provider resource so that
+// injected attributes (e.g. `region`) and the resource identity are present.
+func (l *ListResourceWithSDKv2Resource) SetResourceSchema(resource *schema.Resource) {
+	l.resourceSchema = resource
+}
+
+// RawV5Schemas returns the Plugin SDK v2 resource's schema and identity schema.
+func (l *ListResourceWithSDKv2Resource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	response.ProtoV5Schema = l.resourceSchema.ProtoSchema(ctx)()
+	if f := l.resourceSchema.ProtoIdentitySchema(ctx); f != nil {
+		response.ProtoV5IdentitySchema = f()
+	}
+}
+
+// ResourceData returns a new, empty ResourceData for the Plugin SDK v2 resource.
+func (l *ListResourceWithSDKv2Resource) ResourceData() *schema.ResourceData {
+	return l.resourceSchema.Data(&terraform.InstanceState{})
+}
+
+// SetResult sets the list result's identity and, if requested, its resource object from the specified ResourceData.
+// When the full resource object is requested the resource's Read handler is called to populate the ResourceData.
+// If the resource is not found during Read, the ResourceData's ID is cleared and no result values are set.
+func (l *ListResourceWithSDKv2Resource) SetResult(ctx context.Context, awsClient *conns.AWSClient, includeResource bool, d *schema.ResourceData, result *list.ListResult) {
+	if _, ok := l.resourceSchema.SchemaMap()[names.AttrRegion]; ok {
+		if err := d.Set(names.AttrRegion, awsClient.Region(ctx)); err != nil {
+			result.Diagnostics.AddError("Setting "+names.AttrRegion, err.Error())
+			return
+		}
+	}
+
+	if includeResource {
+		result.Diagnostics.Append(fwdiag.FromSDKDiagnostics(l.resourceSchema.ReadWithoutTimeout(ctx, d, awsClient))...)
+		if result.Diagnostics.HasError() || d.Id() == "" {
+			return
+		}
+	} else {
+		result.Diagnostics.Append(l.setIdentity(ctx, awsClient, d)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	identity, err := d.TfTypeIdentityState()
+	if err != nil {
+		result.Diagnostics.AddError("Converting identity", err.Error())
+		return
+	}
+	result.Identity.Raw = *identity
+
+	if includeResource {
+		resource, err := d.TfTypeResourceState()
+		if err != nil {
+			result.Diagnostics.AddError("Converting resource", err.Error())
+			return
+		}
+		result.Resource.Raw = *resource
+	}
+}
+
+// setIdentity sets the resource identity from the ResourceData without reading the resource.
+func (l *ListResourceWithSDKv2Resource) setIdentity(ctx context.Context, awsClient *conns.AWSClient, d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	identity, err := d.Identity()
+	if err != nil {
+		diags.AddError("Getting identity", err.Error())
+		return diags
+	}
+
+	for k := range l.resourceSchema.Identity.SchemaMap() {
+		var v string
+		switch k {
+		case names.AttrAccountID:
+			v = awsClient.AccountID(ctx)
+		case names.AttrRegion:
+			v = awsClient.Region(ctx)
+		case names.AttrID:
+			v = d.Id()
+		default:
+			v = d.Get(k).(string)
+		}
+
+		if err := identity.Set(k, v); err != nil {
+			diags.AddError("Setting identity "+k, err.Error())
+			return diags
+		}
+	}
+
+	return diags
+}
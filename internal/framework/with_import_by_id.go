@@ -16,5 +16,11 @@ import (
 type WithImportByID struct{}
 
 func (w *WithImportByID) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	// Import by resource identity.
+	if request.ID == "" && request.Identity != nil {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root(names.AttrID), path.Root(names.AttrID), request, response)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
 }
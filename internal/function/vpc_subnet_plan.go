@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+const (
+	// VPC and subnet sizing reference:
+	// https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html
+
+	// subnetMinIPv4PrefixLength is the prefix length of the largest IPv4 block (/16) AWS allows
+	subnetMinIPv4PrefixLength = 16
+	// subnetMaxIPv4PrefixLength is the prefix length of the smallest IPv4 block (/28) AWS allows
+	subnetMaxIPv4PrefixLength = 28
+	// subnetIPv6PrefixLength is the prefix length assigned to IPv6 subnets
+	subnetIPv6PrefixLength = 64
+	// subnetReservedIPv4AddressCount is the number of addresses AWS reserves in each subnet
+	subnetReservedIPv4AddressCount = 5
+)
+
+var vpcSubnetPlanResultAttrTypes = map[string]attr.Type{
+	"availability_zone":          types.StringType,
+	"available_ip_address_count": types.Int64Type,
+	"cidr_block":                 types.StringType,
+	"ipv6_cidr_block":            types.StringType,
+	"tier":                       types.StringType,
+}
+
+var _ function.Function = vpcSubnetPlanFunction{}
+
+func NewVPCSubnetPlanFunction() function.Function {
+	return &vpcSubnetPlanFunction{}
+}
+
+type vpcSubnetPlanFunction struct{}
+
+func (f vpcSubnetPlanFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "vpc_subnet_plan"
+}
+
+func (f vpcSubnetPlanFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "vpc_subnet_plan Function",
+		MarkdownDescription: "Divides a VPC CIDR block into equally sized subnets, one per tier and Availability Zone, " +
+			"honoring AWS subnet sizing constraints. Optionally assigns each subnet a /64 from the VPC's IPv6 CIDR block. " +
+			"The result is a map keyed by \"<tier>-<availability zone>\" that can be used directly as the for_each " +
+			"argument of an aws_subnet resource.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "IPv4 CIDR block of the VPC",
+			},
+			function.ListParameter{
+				Name:                "availability_zones",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Availability Zones to place subnets in",
+			},
+			function.ListParameter{
+				Name:                "tiers",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Subnet tiers, for example public, private and isolated",
+			},
+			function.StringParameter{
+				Name:                "ipv6_cidr_block",
+				AllowNullValue:      true,
+				MarkdownDescription: "IPv6 CIDR block of the VPC, or null for IPv4-only subnets",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: vpcSubnetPlanResultAttrTypes,
+			},
+		},
+	}
+}
+
+func (f vpcSubnetPlanFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+	var availabilityZones, tiers []string
+	var ipv6CIDRBlock *string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock, &availabilityZones, &tiers, &ipv6CIDRBlock))
+	if resp.Error != nil {
+		return
+	}
+
+	plan, err := planSubnets(cidrBlock, availabilityZones, tiers, ipv6CIDRBlock)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	elements := make(map[string]attr.Value, len(plan))
+	for _, v := range plan {
+		ipv6 := types.StringNull()
+		if v.ipv6CIDRBlock != "" {
+			ipv6 = types.StringValue(v.ipv6CIDRBlock)
+		}
+
+		value := map[string]attr.Value{
+			"availability_zone":          types.StringValue(v.availabilityZone),
+			"available_ip_address_count": types.Int64Value(v.availableIPAddressCount),
+			"cidr_block":                 types.StringValue(v.cidrBlock),
+			"ipv6_cidr_block":            ipv6,
+			"tier":                       types.StringValue(v.tier),
+		}
+
+		object, d := types.ObjectValue(vpcSubnetPlanResultAttrTypes, value)
+		if d.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+			return
+		}
+
+		elements[v.key()] = object
+	}
+
+	result, d := types.MapValue(types.ObjectType{AttrTypes: vpcSubnetPlanResultAttrTypes}, elements)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+type plannedSubnet struct {
+	availabilityZone        string
+	availableIPAddressCount int64
+	cidrBlock               string
+	ipv6CIDRBlock           string
+	tier                    string
+}
+
+func (s plannedSubnet) key() string {
+	return fmt.Sprintf("%s-%s", s.tier, s.availabilityZone)
+}
+
+// planSubnets divides the VPC CIDR block into one subnet per tier and
+// Availability Zone. Subnets are allocated tier by tier so that each tier
+// occupies a contiguous range, which keeps route table and NACL rules simple.
+func planSubnets(cidrBlock string, availabilityZones, tiers []string, ipv6CIDRBlock *string) ([]plannedSubnet, error) {
+	if len(availabilityZones) == 0 {
+		return nil, fmt.Errorf("at least one Availability Zone must be specified")
+	}
+	if len(tiers) == 0 {
+		return nil, fmt.Errorf("at least one tier must be specified")
+	}
+	if err := checkUnique("Availability Zone", availabilityZones); err != nil {
+		return nil, err
+	}
+	if err := checkUnique("tier", tiers); err != nil {
+		return nil, err
+	}
+
+	if err := itypes.ValidateCIDRBlock(cidrBlock); err != nil {
+		return nil, err
+	}
+	prefix := netip.MustParsePrefix(cidrBlock)
+	if !prefix.Addr().Is4() {
+		return nil, fmt.Errorf("cidr_block %q must be an IPv4 CIDR block", cidrBlock)
+	}
+	if prefix.Bits() < subnetMinIPv4PrefixLength || prefix.Bits() > subnetMaxIPv4PrefixLength {
+		return nil, fmt.Errorf("cidr_block %q must have a prefix length between /%d and /%d", cidrBlock, subnetMinIPv4PrefixLength, subnetMaxIPv4PrefixLength)
+	}
+
+	count := len(availabilityZones) * len(tiers)
+	newBits := bits.Len(uint(count - 1))
+	subnetBits := prefix.Bits() + newBits
+	if subnetBits > subnetMaxIPv4PrefixLength {
+		return nil, fmt.Errorf("cidr_block %q is too small for %d subnets: subnets would be /%d, the smallest allowed subnet is /%d", cidrBlock, count, subnetBits, subnetMaxIPv4PrefixLength)
+	}
+
+	var ipv6Prefix netip.Prefix
+	if ipv6CIDRBlock != nil {
+		if err := itypes.ValidateCIDRBlock(*ipv6CIDRBlock); err != nil {
+			return nil, err
+		}
+		ipv6Prefix = netip.MustParsePrefix(*ipv6CIDRBlock)
+		if !ipv6Prefix.Addr().Is6() || ipv6Prefix.Addr().Is4In6() {
+			return nil, fmt.Errorf("ipv6_cidr_block %q must be an IPv6 CIDR block", *ipv6CIDRBlock)
+		}
+		if ipv6Prefix.Bits() > subnetIPv6PrefixLength || subnetIPv6PrefixLength-ipv6Prefix.Bits() < newBits {
+			return nil, fmt.Errorf("ipv6_cidr_block %q is too small for %d /%d subnets", *ipv6CIDRBlock, count, subnetIPv6PrefixLength)
+		}
+	}
+
+	base := binary.BigEndian.Uint32(prefix.Addr().AsSlice())
+	hostBits := 32 - subnetBits
+	availableIPAddressCount := int64(1)<<hostBits - subnetReservedIPv4AddressCount
+
+	subnets := make([]plannedSubnet, 0, count)
+	keys := make(map[string]struct{}, count)
+	for i, tier := range tiers {
+		for j, availabilityZone := range availabilityZones {
+			index := uint32(i*len(availabilityZones) + j)
+
+			var b [4]byte
+			binary.BigEndian.PutUint32(b[:], base+index<<hostBits)
+
+			subnet := plannedSubnet{
+				availabilityZone:        availabilityZone,
+				availableIPAddressCount: availableIPAddressCount,
+				cidrBlock:               netip.PrefixFrom(netip.AddrFrom4(b), subnetBits).String(),
+				tier:                    tier,
+			}
+
+			// Keys are "<tier>-<availability zone>", so a tier containing "-" can collide with another tier and zone.
+			key := subnet.key()
+			if _, ok := keys[key]; ok {
+				return nil, fmt.Errorf("tier %q and Availability Zone %q produce duplicate subnet key %q", tier, availabilityZone, key)
+			}
+			keys[key] = struct{}{}
+
+			if ipv6Prefix.IsValid() {
+				subnet.ipv6CIDRBlock = ipv6Subnet(ipv6Prefix, uint64(index)).String()
+			}
+
+			subnets = append(subnets, subnet)
+		}
+	}
+
+	return subnets, nil
+}
+
+// ipv6Subnet returns the index'th /64 subnet of an IPv6 prefix
+func ipv6Subnet(prefix netip.Prefix, index uint64) netip.Prefix {
+	b := prefix.Addr().As16()
+	binary.BigEndian.PutUint64(b[:8], binary.BigEndian.Uint64(b[:8])+index)
+
+	return netip.PrefixFrom(netip.AddrFrom16(b), subnetIPv6PrefixLength)
+}
+
+func checkUnique(name string, values []string) error {
+	seen := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		if v == "" {
+			return fmt.Errorf("%s must not be empty", name)
+		}
+		if _, ok := seen[v]; ok {
+			return fmt.Errorf("duplicate %s %q", name, v)
+		}
+		seen[v] = struct{}{}
+	}
+
+	return nil
+}
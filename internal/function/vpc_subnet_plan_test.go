@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestVPCSubnetPlanFunction_ipv4(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testVPCSubnetPlanFunctionConfig("10.0.0.0/16", `["us-west-2a", "us-west-2b", "us-west-2c"]`, `["public", "private"]`, "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "6"),
+					resource.TestCheckOutput("public_a", "10.0.0.0/19"),
+					resource.TestCheckOutput("private_c", "10.0.160.0/19"),
+					resource.TestCheckOutput("private_c_ip_count", "8187"),
+					resource.TestCheckOutput("private_c_ipv6", "none"),
+				),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testVPCSubnetPlanFunctionConfig("10.0.0.0/24", `["us-west-2a", "us-west-2b", "us-west-2c"]`, `["public", "private"]`, `"2600:1f14:abc:de00::/56"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "6"),
+					resource.TestCheckOutput("public_a", "10.0.0.0/27"),
+					resource.TestCheckOutput("private_c", "10.0.0.160/27"),
+					resource.TestCheckOutput("private_c_ip_count", "27"),
+					resource.TestCheckOutput("private_c_ipv6", "2600:1f14:abc:de05::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_tooSmall(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetPlanFunctionConfig("10.0.0.0/26", `["us-west-2a", "us-west-2b", "us-west-2c"]`, `["public", "private"]`, "null"),
+				ExpectError: regexache.MustCompile(`too[\s\n]*small[\s\n]*for[\s\n]*6[\s\n]*subnets`),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_invalidCIDRBlock(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetPlanFunctionConfig("10.0.0.1/16", `["us-west-2a"]`, `["public"]`, "null"),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*CIDR[\s\n]*block`),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_duplicateKey(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetPlanFunctionConfig("10.0.0.0/16", `["west-2a", "2a"]`, `["us", "us-west"]`, "null"),
+				ExpectError: regexache.MustCompile(`duplicate[\s\n]*subnet[\s\n]*key[\s\n]*"us-west-2a"`),
+			},
+		},
+	})
+}
+
+func testVPCSubnetPlanFunctionConfig(cidrBlock, availabilityZones, tiers, ipv6CIDRBlock string) string {
+	return fmt.Sprintf(`
+locals {
+  plan = provider::aws::vpc_subnet_plan(%[1]q, %[2]s, %[3]s, %[4]s)
+}
+
+output "count" {
+  value = length(local.plan)
+}
+
+output "public_a" {
+  value = local.plan["public-us-west-2a"].cidr_block
+}
+
+output "private_c" {
+  value = local.plan["private-us-west-2c"].cidr_block
+}
+
+output "private_c_ip_count" {
+  value = local.plan["private-us-west-2c"].available_ip_address_count
+}
+
+output "private_c_ipv6" {
+  value = coalesce(local.plan["private-us-west-2c"].ipv6_cidr_block, "none")
+}
+`, cidrBlock, availabilityZones, tiers, ipv6CIDRBlock)
+}
@@ -14,6 +14,7 @@ import (
 	"go/parser"
 	"go/token"
 	"os"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -58,10 +59,13 @@ func main() {
 		v := &visitor{
 			g: g,
 
+			isGlobal: l.IsGlobal(),
+
 			ephemeralResources:   make(map[string]ResourceDatum, 0),
 			frameworkDataSources: make(map[string]ResourceDatum, 0),
 			frameworkResources:   make(map[string]ResourceDatum, 0),
 			sdkDataSources:       make(map[string]ResourceDatum),
+			sdkListResources:     make(map[string]ResourceDatum),
 			sdkResources:         make(map[string]ResourceDatum),
 		}
 
@@ -81,6 +85,7 @@ func main() {
 			FrameworkDataSources:    v.frameworkDataSources,
 			FrameworkResources:      v.frameworkResources,
 			SDKDataSources:          v.sdkDataSources,
+			SDKListResources:        v.sdkListResources,
 			SDKResources:            v.sdkResources,
 		}
 		templateFuncMap := template.FuncMap{
@@ -115,11 +120,16 @@ func main() {
 }
 
 type ResourceDatum struct {
-	FactoryName             string
-	Name                    string // Friendly name (without service name), e.g. "Topic", not "SNS Topic"
-	TransparentTagging      bool
-	TagsIdentifierAttribute string
-	TagsResourceType        string
+	FactoryName                       string
+	Name                              string // Friendly name (without service name), e.g. "Topic", not "SNS Topic"
+	IsGlobal                          bool   // Is the resource global (no Region)?
+	RegionOverrideEnabled             bool
+	ValidateRegionOverrideInPartition bool
+	ARNIdentity                       bool
+	IdentityAttribute                 string
+	TransparentTagging                bool
+	TagsIdentifierAttribute           string
+	TagsResourceType                  string
 }
 
 type ServiceDatum struct {
@@ -132,6 +142,7 @@ type ServiceDatum struct {
 	FrameworkDataSources    map[string]ResourceDatum
 	FrameworkResources      map[string]ResourceDatum
 	SDKDataSources          map[string]ResourceDatum
+	SDKListResources        map[string]ResourceDatum
 	SDKResources            map[string]ResourceDatum
 }
 
@@ -151,6 +162,8 @@ type visitor struct {
 	errs []error
 	g    *common.Generator
 
+	isGlobal bool // Are the service's resources global?
+
 	fileName     string
 	functionName string
 	packageName  string
@@ -159,6 +172,7 @@ type visitor struct {
 	frameworkDataSources map[string]ResourceDatum
 	frameworkResources   map[string]ResourceDatum
 	sdkDataSources       map[string]ResourceDatum
+	sdkListResources     map[string]ResourceDatum
 	sdkResources         map[string]ResourceDatum
 }
 
@@ -201,27 +215,88 @@ func (v *visitor) processFile(file *ast.File) {
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
-	// Look first for tagging annotations.
-	d := ResourceDatum{}
+	// Look first for tagging and Region annotations.
+	d := ResourceDatum{
+		IsGlobal:                          v.isGlobal,
+		RegionOverrideEnabled:             !v.isGlobal,
+		ValidateRegionOverrideInPartition: true,
+	}
 
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
-		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Tags" {
-			args := common.ParseArgs(m[3])
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 {
+			switch annotationName := m[1]; annotationName {
+			case "Region":
+				args := common.ParseArgs(m[3])
+
+				if attr, ok := args.Keyword["global"]; ok {
+					global, err := strconv.ParseBool(attr)
+					if err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid Region/global value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+						continue
+					}
+
+					d.IsGlobal = global
+					d.RegionOverrideEnabled = !global
+				}
 
-			d.TransparentTagging = true
+				if attr, ok := args.Keyword["overrideEnabled"]; ok {
+					enabled, err := strconv.ParseBool(attr)
+					if err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid Region/overrideEnabled value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+						continue
+					}
 
-			if attr, ok := args.Keyword["identifierAttribute"]; ok {
-				if d.TagsIdentifierAttribute != "" {
-					v.errs = append(v.errs, fmt.Errorf("multiple Tags annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					d.RegionOverrideEnabled = enabled
 				}
 
-				d.TagsIdentifierAttribute = namesgen.ConstOrQuote(attr)
-			}
+				if attr, ok := args.Keyword["validateOverrideInPartition"]; ok {
+					validate, err := strconv.ParseBool(attr)
+					if err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid Region/validateOverrideInPartition value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+						continue
+					}
+
+					d.ValidateRegionOverrideInPartition = validate
+				}
+			case "ArnIdentity":
+				if d.ARNIdentity || d.IdentityAttribute != "" {
+					v.errs = append(v.errs, fmt.Errorf("multiple Identity annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				d.ARNIdentity = true
+			case "IdentityAttribute":
+				args := common.ParseArgs(m[3])
+
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no IdentityAttribute attribute name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				if d.ARNIdentity || d.IdentityAttribute != "" {
+					v.errs = append(v.errs, fmt.Errorf("multiple Identity annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				d.IdentityAttribute = namesgen.ConstOrQuote(args.Positional[0])
+			case "Tags":
+				args := common.ParseArgs(m[3])
+
+				d.TransparentTagging = true
+
+				if attr, ok := args.Keyword["identifierAttribute"]; ok {
+					if d.TagsIdentifierAttribute != "" {
+						v.errs = append(v.errs, fmt.Errorf("multiple Tags annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					}
 
-			if attr, ok := args.Keyword["resourceType"]; ok {
-				d.TagsResourceType = attr
+					d.TagsIdentifierAttribute = namesgen.ConstOrQuote(attr)
+				}
+
+				if attr, ok := args.Keyword["resourceType"]; ok {
+					d.TagsResourceType = attr
+				}
 			}
 		}
 	}
@@ -331,6 +406,29 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkDataSources[typeName] = d
 				}
+			case "SDKListResource":
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				typeName := args.Positional[0]
+
+				if !validTypeName.MatchString(typeName) {
+					v.errs = append(v.errs, fmt.Errorf("invalid type name (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				if d.Name == "" {
+					v.errs = append(v.errs, fmt.Errorf("no friendly name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				if _, ok := v.sdkListResources[typeName]; ok {
+					v.errs = append(v.errs, fmt.Errorf("duplicate SDK List Resource (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.sdkListResources[typeName] = d
+				}
 			case "SDKResource":
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -354,7 +452,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "ArnIdentity", "IdentityAttribute", "Region", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// newIdentitySchema returns the identity schema for the specified resource identity information.
+func newIdentitySchema(identity *types.ServicePackageResourceIdentity) identityschema.Schema {
+	s := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			identity.IdentityAttribute: identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+
+	if !identity.IsARN {
+		s.Attributes[names.AttrAccountID] = identityschema.StringAttribute{
+			OptionalForImport: true,
+		}
+
+		if !identity.IsGlobalResource {
+			s.Attributes[names.AttrRegion] = identityschema.StringAttribute{
+				OptionalForImport: true,
+			}
+		}
+	}
+
+	return s
+}
+
+// identityInterceptor implements resource identity functionality.
+type identityInterceptor struct {
+	identity *types.ServicePackageResourceIdentity
+}
+
+func newIdentityInterceptor(identity *types.ServicePackageResourceIdentity) resourceInterceptor {
+	return &identityInterceptor{
+		identity: identity,
+	}
+}
+
+func (r identityInterceptor) create(ctx context.Context, opts interceptorOptions[resource.CreateRequest, resource.CreateResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		diags.Append(r.setIdentity(ctx, opts.c, response.State, response.Identity)...)
+	}
+
+	return diags
+}
+
+func (r identityInterceptor) read(ctx context.Context, opts interceptorOptions[resource.ReadRequest, resource.ReadResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+		if response.State.Raw.IsNull() {
+			return diags
+		}
+
+		diags.Append(r.setIdentity(ctx, opts.c, response.State, response.Identity)...)
+	}
+
+	return diags
+}
+
+func (r identityInterceptor) update(ctx context.Context, opts interceptorOptions[resource.UpdateRequest, resource.UpdateResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		diags.Append(r.setIdentity(ctx, opts.c, response.State, response.Identity)...)
+	}
+
+	return diags
+}
+
+func (r identityInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) diag.Diagnostics {
+	return nil
+}
+
+// setIdentity sets the resource's identity from its state.
+func (r identityInterceptor) setIdentity(ctx context.Context, c *conns.AWSClient, state tfsdk.State, identity *tfsdk.ResourceIdentity) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if identity == nil {
+		return diags
+	}
+
+	var v fwtypes.String
+	diags.Append(state.GetAttribute(ctx, path.Root(r.identity.IdentityAttribute), &v)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(identity.SetAttribute(ctx, path.Root(r.identity.IdentityAttribute), v)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !r.identity.IsARN {
+		diags.Append(identity.SetAttribute(ctx, path.Root(names.AttrAccountID), c.AccountID(ctx))...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if !r.identity.IsGlobalResource {
+			diags.Append(identity.SetAttribute(ctx, path.Root(names.AttrRegion), c.Region(ctx))...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	return diags
+}
+
+// validateImportIdentity verifies that the resource identity specified when importing by identity
+// is in the provider-configured AWS account.
+func validateImportIdentity(ctx context.Context, c *conns.AWSClient, identity *types.ServicePackageResourceIdentity, importIdentity *tfsdk.ResourceIdentity) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var accountID fwtypes.String
+	if identity.IsARN {
+		var v fwtypes.String
+		diags.Append(importIdentity.GetAttribute(ctx, path.Root(identity.IdentityAttribute), &v)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		arn, err := arn.Parse(v.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root(identity.IdentityAttribute), "Invalid Identity Value", err.Error())
+			return diags
+		}
+
+		accountID = fwtypes.StringValue(arn.AccountID)
+	} else {
+		diags.Append(importIdentity.GetAttribute(ctx, path.Root(names.AttrAccountID), &accountID)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if v := accountID.ValueString(); v != "" && v != c.AccountID(ctx) {
+		diags.AddError(
+			"Invalid Identity Value",
+			fmt.Sprintf("identity account ID (%s) does not match the provider-configured account ID (%s)", v, c.AccountID(ctx)),
+		)
+	}
+
+	// Any Region override has already been taken from the identity, so a mismatch means that
+	// the resource can only be managed in the provider-configured Region.
+	if !identity.IsGlobalResource && !identity.IsARN {
+		var region fwtypes.String
+		diags.Append(importIdentity.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if v := region.ValueString(); v != "" && v != c.Region(ctx) {
+			diags.AddError(
+				"Invalid Identity Value",
+				fmt.Sprintf("identity Region (%s) does not match the provider-configured Region (%s)", v, c.Region(ctx)),
+			)
+		}
+	}
+
+	return diags
+}
@@ -8,18 +8,23 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
@@ -31,17 +36,18 @@ import (
 var _ provider.Provider = &fwprovider{}
 var _ provider.ProviderWithFunctions = &fwprovider{}
 var _ provider.ProviderWithEphemeralResources = &fwprovider{}
+var _ provider.ProviderWithListResources = &fwprovider{}
 
 // New returns a new, initialized Terraform Plugin Framework-style provider instance.
 // The provider instance is fully configured once the `Configure` method has been called.
-func New(primary interface{ Meta() any }) provider.Provider {
+func New(primary *sdkschema.Provider) provider.Provider {
 	return &fwprovider{
 		Primary: primary,
 	}
 }
 
 type fwprovider struct {
-	Primary interface{ Meta() any }
+	Primary *sdkschema.Provider
 }
 
 func (*fwprovider) Metadata(ctx context.Context, request provider.MetadataRequest, response *provider.MetadataResponse) {
@@ -61,6 +67,11 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"audit_log_path": schema.StringAttribute{
+				Optional: true,
+				Description: "Path of a file to which a JSON Lines audit trail of AWS API calls is appended. " +
+					"Can also be configured using the `" + conns.AuditLogPathEnvVar + "` environment variable.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -293,6 +304,70 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 					},
 				},
 			},
+			"rate_limit": schema.ListNestedBlock{
+				Description: "Configuration block with client-side rate limiting settings for a service or a single operation of a service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_in_flight": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+							Description: "Maximum number of concurrent requests.",
+						},
+						"operation": schema.StringAttribute{
+							Optional: true,
+							Description: "API operation name, for example `ChangeResourceRecordSets`. " +
+								"If not set, the limits apply to all of the service's operations.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional: true,
+							Validators: []validator.Float64{
+								float64validator.AtLeast(0.001),
+							},
+							Description: "Maximum sustained rate at which requests are sent.",
+						},
+						"service": schema.StringAttribute{
+							Required: true,
+							Description: "Service name, as used in the `endpoints` configuration block, " +
+								"for example `route53`.",
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to validate resource tags against a tag policy at plan time.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"required_keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag keys that must be present on all tagged resources.",
+						},
+						"severity": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								enum.FrameworkValidate[tftags.PolicySeverity](),
+							},
+							Description: "Severity of the diagnostic reported for tag policy violations. " +
+								"Valid values are `error` and `warning`. Defaults to `error`.",
+						},
+						"use_organizations_policy": schema.BoolAttribute{
+							Optional: true,
+							Description: "Load the account's effective AWS Organizations tag policy " +
+								"and validate tag key capitalization and allowed values against it.",
+						},
+						"value_patterns": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Map of resource tag keys to regular expressions that the tag values must match.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -306,6 +381,7 @@ func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRe
 	response.DataSourceData = v
 	response.ResourceData = v
 	response.EphemeralResourceData = v
+	response.ListResourceData = v
 }
 
 // DataSources returns a slice of functions to instantiate each DataSource
@@ -332,7 +408,19 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 
 			typeName := v.TypeName
+			isRegionOverrideEnabled := v.Region != nil && v.Region.IsOverrideEnabled
 			interceptors := dataSourceInterceptors{}
+			if isRegionOverrideEnabled {
+				schemaResponse := datasource.SchemaResponse{}
+				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s", names.AttrRegion, typeName))
+					continue
+				}
+
+				inner = newRegionDataSource(inner)
+			}
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
 				// Ensure that the schema look OK.
@@ -354,11 +442,18 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 			opts := wrappedDataSourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
-					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+					overrideRegion := overrideRegionFromAttribute(ctx, isRegionOverrideEnabled, getAttribute)
+					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName, overrideRegion)
 					if c != nil {
+						if overrideRegion != "" && v.Region.IsValidateOverrideInPartition {
+							if err := c.ValidateInContextRegionInPartition(ctx); err != nil {
+								diags.AddError("Invalid Region Value", err.Error())
+								return ctx, diags
+							}
+						}
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
 						ctx = flex.RegisterLogger(ctx)
@@ -404,8 +499,20 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			}
 
 			typeName := v.TypeName
+			isRegionOverrideEnabled := v.Region != nil && v.Region.IsOverrideEnabled
 			var modifyPlanFuncs []modifyPlanFunc
 			interceptors := resourceInterceptors{}
+			if isRegionOverrideEnabled {
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s", names.AttrRegion, typeName))
+					continue
+				}
+
+				inner = newRegionResource(inner)
+			}
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
@@ -434,14 +541,34 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				modifyPlanFuncs = append(modifyPlanFuncs, setTagsAll)
 				interceptors = append(interceptors, newTagsResourceInterceptor(v.Tags))
 			}
+			if v.Identity != nil {
+				if v.Identity.IdentityAttribute != names.AttrID {
+					schemaResponse := resource.SchemaResponse{}
+					inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+					if _, ok := schemaResponse.Schema.Attributes[v.Identity.IdentityAttribute]; !ok {
+						errs = append(errs, fmt.Errorf("no `%s` identity attribute defined in schema: %s", v.Identity.IdentityAttribute, typeName))
+						continue
+					}
+				}
+
+				interceptors = append(interceptors, newIdentityInterceptor(v.Identity))
+			}
 
 			opts := wrappedResourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+					overrideRegion := overrideRegionFromAttribute(ctx, isRegionOverrideEnabled, getAttribute)
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName, overrideRegion)
 					if c != nil {
+						if overrideRegion != "" && v.Region.IsValidateOverrideInPartition {
+							if err := c.ValidateInContextRegionInPartition(ctx); err != nil {
+								diags.AddError("Invalid Region Value", err.Error())
+								return ctx, diags
+							}
+						}
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
 						ctx = flex.RegisterLogger(ctx)
@@ -449,6 +576,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 					return ctx, diags
 				},
+				identity:        v.Identity,
 				interceptors:    interceptors,
 				modifyPlanFuncs: modifyPlanFuncs,
 				typeName:        typeName,
@@ -492,14 +620,35 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 					continue
 				}
 
+				typeName := v.TypeName
+				isRegionOverrideEnabled := v.Region != nil && v.Region.IsOverrideEnabled
+				if isRegionOverrideEnabled {
+					schemaResponse := ephemeral.SchemaResponse{}
+					inner.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResponse)
+
+					if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+						errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s", names.AttrRegion, typeName))
+						continue
+					}
+
+					inner = newRegionEphemeralResource(inner)
+				}
+
 				interceptors := ephemeralResourceInterceptors{}
 				opts := wrappedEphemeralResourceOptions{
 					// bootstrapContext is run on all wrapped methods before any interceptors.
-					bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+					bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 						var diags diag.Diagnostics
 
-						ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name)
+						overrideRegion := overrideRegionFromAttribute(ctx, isRegionOverrideEnabled, getAttribute)
+						ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name, typeName, overrideRegion)
 						if c != nil {
+							if overrideRegion != "" && v.Region.IsValidateOverrideInPartition {
+								if err := c.ValidateInContextRegionInPartition(ctx); err != nil {
+									diags.AddError("Invalid Region Value", err.Error())
+									return ctx, diags
+								}
+							}
 							ctx = c.RegisterLogger(ctx)
 							ctx = flex.RegisterLogger(ctx)
 							ctx = logging.MaskSensitiveValuesByKey(ctx, logging.HTTPKeyRequestBody, logging.HTTPKeyResponseBody)
@@ -507,7 +656,7 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 						return ctx, diags
 					},
 					interceptors: interceptors,
-					typeName:     v.TypeName,
+					typeName:     typeName,
 				}
 				ephemeralResources = append(ephemeralResources, func() ephemeral.EphemeralResource {
 					return newWrappedEphemeralResource(inner, opts)
@@ -525,6 +674,89 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 	return ephemeralResources
 }
 
+// ListResources returns a slice of functions to instantiate each List Resource
+// implementation.
+//
+// Each list resource lists instances of the Plugin SDK v2 resource with the same type name.
+// All list resources must have unique type names.
+func (p *fwprovider) ListResources(ctx context.Context) []func() list.ListResource {
+	var errs []error
+	var listResources []func() list.ListResource
+
+	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages(ctx) {
+		if data, ok := sp.(conns.ServicePackageWithSDKListResources); ok {
+			servicePackageName := data.ServicePackageName()
+
+			for _, v := range data.SDKListResources(ctx) {
+				typeName := v.TypeName
+
+				// The list resource's results have the schemas of the registered (wrapped) Plugin SDK v2 resource.
+				r, ok := p.Primary.ResourcesMap[typeName]
+				if !ok {
+					errs = append(errs, fmt.Errorf("no resource defined for list resource: %s", typeName))
+					continue
+				}
+				if r.Identity == nil {
+					errs = append(errs, fmt.Errorf("resource identity not defined for list resource: %s", typeName))
+					continue
+				}
+
+				listResource := v.Factory()
+				listResource.SetResourceSchema(r)
+
+				var inner sdkListResource = listResource
+				isRegionOverrideEnabled := v.Region != nil && v.Region.IsOverrideEnabled
+				if isRegionOverrideEnabled {
+					schemaResponse := list.ListResourceSchemaResponse{}
+					inner.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResponse)
+
+					if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+						errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s", names.AttrRegion, typeName))
+						continue
+					}
+
+					inner = newRegionListResource(inner)
+				}
+
+				opts := wrappedListResourceOptions{
+					// bootstrapContext is run on all wrapped methods.
+					bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+						var diags diag.Diagnostics
+
+						overrideRegion := overrideRegionFromAttribute(ctx, isRegionOverrideEnabled, getAttribute)
+						ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName, overrideRegion)
+						if c != nil {
+							if overrideRegion != "" && v.Region.IsValidateOverrideInPartition {
+								if err := c.ValidateInContextRegionInPartition(ctx); err != nil {
+									diags.AddError("Invalid Region Value", err.Error())
+									return ctx, diags
+								}
+							}
+							ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
+							ctx = c.RegisterLogger(ctx)
+							ctx = flex.RegisterLogger(ctx)
+						}
+
+						return ctx, diags
+					},
+					typeName: typeName,
+				}
+				listResources = append(listResources, func() list.ListResource {
+					return newWrappedListResource(inner, opts)
+				})
+			}
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tflog.Warn(ctx, "registering list resources", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	return listResources
+}
+
 // Functions returns a slice of functions to instantiate each Function
 // implementation.
 //
@@ -534,6 +766,9 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
 		tffunction.NewTrimIAMRolePathFunction,
+		tffunction.NewVPCSubnetPlanFunction,
 	}
 }
@@ -0,0 +1,1043 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	erschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	lrschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Per-resource Region override is implemented by decorating the service package's data source, ephemeral resource, list resource or resource.
+// The decorator adds the top-level `region` attribute to the inner schema and transparently removes the attribute
+// from values passed to the inner implementation and restores the attribute in values returned from the inner implementation.
+// Inner implementations are unaware of the attribute; the in-effect Region is available via `(*conns.AWSClient).Region(ctx)`.
+
+func regionDataSourceAttribute() dsschema.Attribute {
+	return dsschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: names.TopLevelRegionAttributeDescription,
+		Validators: []validator.String{
+			fwvalidators.AWSRegion(),
+		},
+	}
+}
+
+func regionEphemeralResourceAttribute() erschema.Attribute {
+	return erschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: names.TopLevelRegionAttributeDescription,
+		Validators: []validator.String{
+			fwvalidators.AWSRegion(),
+		},
+	}
+}
+
+func regionListResourceAttribute() lrschema.Attribute {
+	return lrschema.StringAttribute{
+		Optional:    true,
+		Description: names.TopLevelRegionAttributeDescription,
+		Validators: []validator.String{
+			fwvalidators.AWSRegion(),
+		},
+	}
+}
+
+func regionResourceAttribute() rschema.Attribute {
+	return rschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: names.TopLevelRegionAttributeDescription,
+		Validators: []validator.String{
+			fwvalidators.AWSRegion(),
+		},
+	}
+}
+
+// overrideRegionFromAttribute returns any per-resource Region override value from the `region` attribute.
+func overrideRegionFromAttribute(ctx context.Context, isRegionOverrideEnabled bool, getAttribute getAttributeFunc) string {
+	if isRegionOverrideEnabled && getAttribute != nil {
+		var target types.String
+		if diags := getAttribute(ctx, path.Root(names.AttrRegion), &target); !diags.HasError() {
+			return target.ValueString()
+		}
+	}
+
+	return ""
+}
+
+// withoutRegion returns the specified object value with the top-level `region` attribute removed
+// along with the removed attribute's value.
+func withoutRegion(v tftypes.Value) (tftypes.Value, tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	null := tftypes.NewValue(tftypes.String, nil)
+
+	if v.Type() == nil {
+		return v, null, diags
+	}
+
+	typ, ok := v.Type().(tftypes.Object)
+	if !ok {
+		diags.AddError("Removing region attribute", fmt.Sprintf("unexpected value type: %s", v.Type()))
+		return v, null, diags
+	}
+
+	attrType, ok := typ.AttributeTypes[names.AttrRegion]
+	if !ok {
+		return v, null, diags
+	}
+
+	attrTypes := maps.Clone(typ.AttributeTypes)
+	delete(attrTypes, names.AttrRegion)
+	optionalAttrs := maps.Clone(typ.OptionalAttributes)
+	delete(optionalAttrs, names.AttrRegion)
+	typ = tftypes.Object{
+		AttributeTypes:     attrTypes,
+		OptionalAttributes: optionalAttrs,
+	}
+
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), tftypes.NewValue(attrType, nil), diags
+	}
+
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), tftypes.NewValue(attrType, tftypes.UnknownValue), diags
+	}
+
+	var vals map[string]tftypes.Value
+	if err := v.As(&vals); err != nil {
+		diags.AddError("Removing region attribute", err.Error())
+		return v, null, diags
+	}
+
+	region := vals[names.AttrRegion]
+	delete(vals, names.AttrRegion)
+
+	return tftypes.NewValue(typ, vals), region, diags
+}
+
+// withRegion returns the specified object value with the top-level `region` attribute set.
+// typ is the object type including the `region` attribute.
+func withRegion(v tftypes.Value, typ tftypes.Type, region tftypes.Value) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.Type() == nil {
+		return v, diags
+	}
+
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), diags
+	}
+
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), diags
+	}
+
+	var vals map[string]tftypes.Value
+	if err := v.As(&vals); err != nil {
+		diags.AddError("Adding region attribute", err.Error())
+		return v, diags
+	}
+
+	vals[names.AttrRegion] = region
+
+	return tftypes.NewValue(typ, vals), diags
+}
+
+// regionValueString returns the specified region value as a string.
+// Null and unknown values are returned as "".
+func regionValueString(v tftypes.Value) string {
+	var region string
+
+	if v.Type() != nil && v.IsKnown() && !v.IsNull() {
+		if err := v.As(&region); err != nil {
+			return ""
+		}
+	}
+
+	return region
+}
+
+// regionFromRawState returns any top-level `region` attribute value from the specified raw state.
+func regionFromRawState(rawState *tfprotov6.RawState) tftypes.Value {
+	if rawState != nil && rawState.JSON != nil {
+		var m map[string]any
+		if err := json.Unmarshal(rawState.JSON, &m); err == nil {
+			if v, ok := m[names.AttrRegion].(string); ok && v != "" {
+				return tftypes.NewValue(tftypes.String, v)
+			}
+		}
+	}
+
+	return tftypes.NewValue(tftypes.String, nil)
+}
+
+// regionInState returns the value of the top-level `region` attribute to be set in state.
+// If no Region is known the in-effect Region is used.
+func regionInState(ctx context.Context, c *conns.AWSClient, v tftypes.Value) tftypes.Value {
+	if regionValueString(v) == "" && c != nil {
+		return tftypes.NewValue(tftypes.String, c.Region(ctx))
+	}
+
+	return v
+}
+
+// regionDataSource implements per-resource Region override for a data source.
+type regionDataSource struct {
+	inner       datasource.DataSourceWithConfigure
+	meta        *conns.AWSClient
+	schemaOnce  sync.Once
+	innerSchema dsschema.Schema
+}
+
+func newRegionDataSource(inner datasource.DataSourceWithConfigure) datasource.DataSourceWithConfigure {
+	return &regionDataSource{
+		inner: inner,
+	}
+}
+
+func (r *regionDataSource) schema(ctx context.Context) dsschema.Schema {
+	r.schemaOnce.Do(func() {
+		response := datasource.SchemaResponse{}
+		r.inner.Schema(ctx, datasource.SchemaRequest{}, &response)
+		r.innerSchema = response.Schema
+	})
+
+	return r.innerSchema
+}
+
+func (r *regionDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	r.inner.Metadata(ctx, request, response)
+}
+
+func (r *regionDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	r.inner.Schema(ctx, request, response)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Schema.Attributes = maps.Clone(response.Schema.Attributes)
+	if response.Schema.Attributes == nil {
+		response.Schema.Attributes = make(map[string]dsschema.Attribute)
+	}
+	response.Schema.Attributes[names.AttrRegion] = regionDataSourceAttribute()
+}
+
+func (r *regionDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		r.meta = v
+	}
+
+	r.inner.Configure(ctx, request, response)
+}
+
+func (r *regionDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	innerSchema := r.schema(ctx)
+
+	config, region, diags := withoutRegion(request.Config.Raw)
+	response.Diagnostics.Append(diags...)
+	state, _, diags := withoutRegion(response.State.Raw)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	innerRequest := datasource.ReadRequest{
+		Config:             tfsdk.Config{Raw: config, Schema: innerSchema},
+		ProviderMeta:       request.ProviderMeta,
+		ClientCapabilities: request.ClientCapabilities,
+	}
+	innerResponse := datasource.ReadResponse{
+		State:       tfsdk.State{Raw: state, Schema: innerSchema},
+		Diagnostics: response.Diagnostics,
+		Deferred:    response.Deferred,
+	}
+	r.inner.Read(ctx, innerRequest, &innerResponse)
+
+	response.Diagnostics = innerResponse.Diagnostics
+	response.Deferred = innerResponse.Deferred
+	response.State.Raw, diags = withRegion(innerResponse.State.Raw, response.State.Schema.Type().TerraformType(ctx), regionInState(ctx, r.meta, region))
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *regionDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	if v, ok := r.inner.(datasource.DataSourceWithConfigValidators); ok {
+		var validators []datasource.ConfigValidator
+
+		for _, validator := range v.ConfigValidators(ctx) {
+			validators = append(validators, &regionDataSourceConfigValidator{
+				inner:  validator,
+				schema: r.schema,
+			})
+		}
+
+		return validators
+	}
+
+	return nil
+}
+
+func (r *regionDataSource) ValidateConfig(ctx context.Context, request datasource.ValidateConfigRequest, response *datasource.ValidateConfigResponse) {
+	if v, ok := r.inner.(datasource.DataSourceWithValidateConfig); ok {
+		config, _, diags := withoutRegion(request.Config.Raw)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		v.ValidateConfig(ctx, datasource.ValidateConfigRequest{Config: tfsdk.Config{Raw: config, Schema: r.schema(ctx)}}, response)
+	}
+}
+
+type regionDataSourceConfigValidator struct {
+	inner  datasource.ConfigValidator
+	schema func(context.Context) dsschema.Schema
+}
+
+func (v *regionDataSourceConfigValidator) Description(ctx context.Context) string {
+	return v.inner.Description(ctx)
+}
+
+func (v *regionDataSourceConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return v.inner.MarkdownDescription(ctx)
+}
+
+func (v *regionDataSourceConfigValidator) ValidateDataSource(ctx context.Context, request datasource.ValidateConfigRequest, response *datasource.ValidateConfigResponse) {
+	config, _, diags := withoutRegion(request.Config.Raw)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	v.inner.ValidateDataSource(ctx, datasource.ValidateConfigRequest{Config: tfsdk.Config{Raw: config, Schema: v.schema(ctx)}}, response)
+}
+
+// regionEphemeralResource implements per-resource Region override for an ephemeral resource.
+type regionEphemeralResource struct {
+	inner       ephemeral.EphemeralResourceWithConfigure
+	meta        *conns.AWSClient
+	schemaOnce  sync.Once
+	innerSchema erschema.Schema
+}
+
+func newRegionEphemeralResource(inner ephemeral.EphemeralResourceWithConfigure) ephemeral.EphemeralResourceWithConfigure {
+	return &regionEphemeralResource{
+		inner: inner,
+	}
+}
+
+func (r *regionEphemeralResource) schema(ctx context.Context) erschema.Schema {
+	r.schemaOnce.Do(func() {
+		response := ephemeral.SchemaResponse{}
+		r.inner.Schema(ctx, ephemeral.SchemaRequest{}, &response)
+		r.innerSchema = response.Schema
+	})
+
+	return r.innerSchema
+}
+
+func (r *regionEphemeralResource) Metadata(ctx context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	r.inner.Metadata(ctx, request, response)
+}
+
+func (r *regionEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	r.inner.Schema(ctx, request, response)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Schema.Attributes = maps.Clone(response.Schema.Attributes)
+	if response.Schema.Attributes == nil {
+		response.Schema.Attributes = make(map[string]erschema.Attribute)
+	}
+	response.Schema.Attributes[names.AttrRegion] = regionEphemeralResourceAttribute()
+}
+
+func (r *regionEphemeralResource) Configure(ctx context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		r.meta = v
+	}
+
+	r.inner.Configure(ctx, request, response)
+}
+
+func (r *regionEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	innerSchema := r.schema(ctx)
+
+	config, region, diags := withoutRegion(request.Config.Raw)
+	response.Diagnostics.Append(diags...)
+	result, _, diags := withoutRegion(response.Result.Raw)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	innerRequest := ephemeral.OpenRequest{
+		Config:             tfsdk.Config{Raw: config, Schema: innerSchema},
+		ClientCapabilities: request.ClientCapabilities,
+	}
+	innerResponse := ephemeral.OpenResponse{
+		Result:      tfsdk.EphemeralResultData{Raw: result, Schema: innerSchema},
+		Private:     response.Private,
+		RenewAt:     response.RenewAt,
+		Diagnostics: response.Diagnostics,
+		Deferred:    response.Deferred,
+	}
+	r.inner.Open(ctx, innerRequest, &innerResponse)
+
+	response.Private = innerResponse.Private
+	response.RenewAt = innerResponse.RenewAt
+	response.Diagnostics = innerResponse.Diagnostics
+	response.Deferred = innerResponse.Deferred
+	response.Result.Raw, diags = withRegion(innerResponse.Result.Raw, response.Result.Schema.Type().TerraformType(ctx), regionInState(ctx, r.meta, region))
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *regionEphemeralResource) Renew(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) {
+	if v, ok := r.inner.(ephemeral.EphemeralResourceWithRenew); ok {
+		v.Renew(ctx, request, response)
+	}
+}
+
+func (r *regionEphemeralResource) Close(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) {
+	if v, ok := r.inner.(ephemeral.EphemeralResourceWithClose); ok {
+		v.Close(ctx, request, response)
+	}
+}
+
+func (r *regionEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	if v, ok := r.inner.(ephemeral.EphemeralResourceWithConfigValidators); ok {
+		var validators []ephemeral.ConfigValidator
+
+		for _, validator := range v.ConfigValidators(ctx) {
+			validators = append(validators, &regionEphemeralResourceConfigValidator{
+				inner:  validator,
+				schema: r.schema,
+			})
+		}
+
+		return validators
+	}
+
+	return nil
+}
+
+func (r *regionEphemeralResource) ValidateConfig(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
+	if v, ok := r.inner.(ephemeral.EphemeralResourceWithValidateConfig); ok {
+		config, _, diags := withoutRegion(request.Config.Raw)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		v.ValidateConfig(ctx, ephemeral.ValidateConfigRequest{Config: tfsdk.Config{Raw: config, Schema: r.schema(ctx)}}, response)
+	}
+}
+
+type regionEphemeralResourceConfigValidator struct {
+	inner  ephemeral.ConfigValidator
+	schema func(context.Context) erschema.Schema
+}
+
+func (v *regionEphemeralResourceConfigValidator) Description(ctx context.Context) string {
+	return v.inner.Description(ctx)
+}
+
+func (v *regionEphemeralResourceConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return v.inner.MarkdownDescription(ctx)
+}
+
+func (v *regionEphemeralResourceConfigValidator) ValidateEphemeralResource(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
+	config, _, diags := withoutRegion(request.Config.Raw)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	v.inner.ValidateEphemeralResource(ctx, ephemeral.ValidateConfigRequest{Config: tfsdk.Config{Raw: config, Schema: v.schema(ctx)}}, response)
+}
+
+// regionResource implements per-resource Region override for a resource.
+type regionResource struct {
+	inner       resource.ResourceWithConfigure
+	meta        *conns.AWSClient
+	schemaOnce  sync.Once
+	innerSchema rschema.Schema
+}
+
+func newRegionResource(inner resource.ResourceWithConfigure) resource.ResourceWithConfigure {
+	return &regionResource{
+		inner: inner,
+	}
+}
+
+func (r *regionResource) schema(ctx context.Context) rschema.Schema {
+	r.schemaOnce.Do(func() {
+		response := resource.SchemaResponse{}
+		r.inner.Schema(ctx, resource.SchemaRequest{}, &response)
+		r.innerSchema = response.Schema
+	})
+
+	return r.innerSchema
+}
+
+func (r *regionResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	r.inner.Metadata(ctx, request, response)
+}
+
+func (r *regionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	r.inner.Schema(ctx, request, response)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Schema = withRegionAttribute(response.Schema)
+}
+
+// withRegionAttribute returns a copy of the specified resource schema with the top-level `region` attribute added.
+func withRegionAttribute(s rschema.Schema) rschema.Schema {
+	s.Attributes = maps.Clone(s.Attributes)
+	if s.Attributes == nil {
+		s.Attributes = make(map[string]rschema.Attribute)
+	}
+	s.Attributes[names.AttrRegion] = regionResourceAttribute()
+
+	return s
+}
+
+func (r *regionResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		r.meta = v
+	}
+
+	r.inner.Configure(ctx, request, response)
+}
+
+func (r *regionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	innerSchema := r.schema(ctx)
+
+	config, _, diags := withoutRegion(request.Config.Raw)
+	response.Diagnostics.Append(diags...)
+	plan, region, diags := withoutRegion(request.Plan.Raw)
+	response.Diagnostics.Append(diags...)
+	state, _, diags := withoutRegion(response.State.Raw)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	innerRequest := resource.CreateRequest{
+		Config:       tfsdk.Config{Raw: config, Schema: innerSchema},
+		Plan:         tfsdk.Plan{Raw: plan, Schema: innerSchema},
+		ProviderMeta: request.ProviderMeta,
+		Identity:     request.Identity,
+	}
+	innerResponse := resource.CreateResponse{
+		State:       tfsdk.State{Raw: state, Schema: innerSchema},
+		Private:     response.Private,
+		Identity:    response.Identity,
+		Diagnostics: response.Diagnostics,
+	}
+	r.inner.Create(ctx, innerRequest, &innerResponse)
+
+	response.Private = innerResponse.Private
+	response.Identity = innerResponse.Identity
+	response.Diagnostics = innerResponse.Diagnostics
+	response.State.Raw, diags = withRegion(innerResponse.State.Raw, response.State.Schema.Type().TerraformType(ctx), regionInState(ctx, r.meta, region))
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *regionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	innerSchema := r.schema(ctx)
+
+	state, region, diags := withoutRegion(request.State.Raw)
+	response.Diagnostics.Append(diags...)
+	newState, _, diags := withoutRegion(response.State.Raw)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	innerRequest := resource.ReadRequest{
+		State:              tfsdk.State{Raw: state, Schema: innerSchema},
+		Private:            request.Private,
+		ProviderMeta:       request.ProviderMeta,
+		ClientCapabilities: request.ClientCapabilities,
+		Identity:           request.Identity,
+	}
+	innerResponse := resource.ReadResponse{
+		State:       tfsdk.State{Raw: newState, Schema: innerSchema},
+		Private:     response.Private,
+		Identity:    response.Identity,
+		Diagnostics: response.Diagnostics,
+		Deferred:    response.Deferred,
+	}
+	r.inner.Read(ctx, innerRequest, &innerResponse)
+
+	response.Private = innerResponse.Private
+	response.Identity = innerResponse.Identity
+	response.Diagnostics = innerResponse.Diagnostics
+	response.Deferred = innerResponse.Deferred
+	response.State.Raw, diags = withRegion(innerResponse.State.Raw, response.State.Schema.Type().TerraformType(ctx), regionInState(ctx, r.meta, region))
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *regionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	innerSchema := r.schema(ctx)
+
+	config, _, diags := withoutRegion(request.Config.Raw)
+	response.Diagnostics.Append(diags...)
+	plan, region, diags := withoutRegion(request.Plan.Raw)
+	response.Diagnostics.Append(diags...)
+	state, _, diags := withoutRegion(request.State.Raw)
+	response.Diagnostics.Append(diags...)
+	newState, _, diags := withoutRegion(response.State.Raw)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	innerRequest := resource.UpdateRequest{
+		Config:       tfsdk.Config{Raw: config, Schema: innerSchema},
+		Plan:         tfsdk.Plan{Raw: plan, Schema: innerSchema},
+		State:        tfsdk.State{Raw: state, Schema: innerSchema},
+		ProviderMeta: request.ProviderMeta,
+		Private:      request.Private,
+		Identity:     request.Identity,
+	}
+	innerResponse := resource.UpdateResponse{
+		State:       tfsdk.State{Raw: newState, Schema: innerSchema},
+		Private:     response.Private,
+		Identity:    response.Identity,
+		Diagnostics: response.Diagnostics,
+	}
+	r.inner.Update(ctx, innerRequest, &innerResponse)
+
+	response.Private = innerResponse.Private
+	response.Identity = innerResponse.Identity
+	response.Diagnostics = innerResponse.Diagnostics
+	response.State.Raw, diags = withRegion(innerResponse.State.Raw, response.State.Schema.Type().TerraformType(ctx), regionInState(ctx, r.meta, region))
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *regionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	innerSchema := r.schema(ctx)
+
+	state, region, diags := withoutRegion(request.State.Raw)
+	response.Diagnostics.Append(diags...)
+	newState, _, diags := withoutRegion(response.State.Raw)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	innerRequest := resource.DeleteRequest{
+		State:        tfsdk.State{Raw: state, Schema: innerSchema},
+		ProviderMeta: request.ProviderMeta,
+		Private:      request.Private,
+		Identity:     request.Identity,
+	}
+	innerResponse := resource.DeleteResponse{
+		State:       tfsdk.State{Raw: newState, Schema: innerSchema},
+		Private:     response.Private,
+		Identity:    response.Identity,
+		Diagnostics: response.Diagnostics,
+	}
+	r.inner.Delete(ctx, innerRequest, &innerResponse)
+
+	response.Private = innerResponse.Private
+	response.Identity = innerResponse.Identity
+	response.Diagnostics = innerResponse.Diagnostics
+	response.State.Raw, diags = withRegion(innerResponse.State.Raw, response.State.Schema.Type().TerraformType(ctx), region)
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *regionResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	v, ok := r.inner.(resource.ResourceWithImportState)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Resource Import Not Implemented",
+			"This resource does not support import. Please contact the provider developer for additional information.",
+		)
+
+		return
+	}
+
+	// The import ID may be suffixed with `@<region>` to import a resource in a Region other than the provider-configured Region,
+	// otherwise if the import ID is a regional ARN its Region is used.
+	// When importing by identity, the Region of any ARN identity is used.
+	id, region := request.ID, ""
+	if i := strings.LastIndex(id, "@"); i >= 0 && inttypes.IsAWSRegion(id[i+1:]) {
+		id, region = id[:i], id[i+1:]
+	} else if v, err := arn.Parse(id); err == nil {
+		region = v.Region
+	} else if id == "" && request.Identity != nil {
+		var v types.String
+		if diags := request.Identity.GetAttribute(ctx, path.Root(names.AttrARN), &v); !diags.HasError() {
+			if v, err := arn.Parse(v.ValueString()); err == nil {
+				region = v.Region
+			}
+		}
+	}
+	if region != "" {
+		if inContext, ok := conns.FromContext(ctx); ok {
+			ctx = conns.NewResourceContext(ctx, inContext.ServicePackageName(), inContext.ResourceName(), inContext.TypeName(), region)
+		}
+	}
+
+	innerSchema := r.schema(ctx)
+
+	state, _, diags := withoutRegion(response.State.Raw)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	innerRequest := resource.ImportStateRequest{
+		ID:                 id,
+		ClientCapabilities: request.ClientCapabilities,
+		Identity:           request.Identity,
+	}
+	innerResponse := resource.ImportStateResponse{
+		State:       tfsdk.State{Raw: state, Schema: innerSchema},
+		Private:     response.Private,
+		Identity:    response.Identity,
+		Diagnostics: response.Diagnostics,
+		Deferred:    response.Deferred,
+	}
+	v.ImportState(ctx, innerRequest, &innerResponse)
+
+	response.Private = innerResponse.Private
+	response.Identity = innerResponse.Identity
+	response.Diagnostics = innerResponse.Diagnostics
+	response.Deferred = innerResponse.Deferred
+	response.State.Raw, diags = withRegion(innerResponse.State.Raw, response.State.Schema.Type().TerraformType(ctx), regionInState(ctx, r.meta, tftypes.NewValue(tftypes.String, nil)))
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *regionResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	innerSchema := r.schema(ctx)
+
+	config, configRegion, diags := withoutRegion(request.Config.Raw)
+	response.Diagnostics.Append(diags...)
+	state, stateRegion, diags := withoutRegion(request.State.Raw)
+	response.Diagnostics.Append(diags...)
+	plan, _, diags := withoutRegion(request.Plan.Raw)
+	response.Diagnostics.Append(diags...)
+	newPlan, planRegion, diags := withoutRegion(response.Plan.Raw)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if v, ok := r.inner.(resource.ResourceWithModifyPlan); ok {
+		innerRequest := resource.ModifyPlanRequest{
+			Config:             tfsdk.Config{Raw: config, Schema: innerSchema},
+			State:              tfsdk.State{Raw: state, Schema: innerSchema},
+			Plan:               tfsdk.Plan{Raw: plan, Schema: innerSchema},
+			ProviderMeta:       request.ProviderMeta,
+			Private:            request.Private,
+			ClientCapabilities: request.ClientCapabilities,
+			Identity:           request.Identity,
+		}
+		innerResponse := resource.ModifyPlanResponse{
+			Plan:            tfsdk.Plan{Raw: newPlan, Schema: innerSchema},
+			RequiresReplace: response.RequiresReplace,
+			Private:         response.Private,
+			Identity:        response.Identity,
+			Diagnostics:     response.Diagnostics,
+			Deferred:        response.Deferred,
+		}
+		v.ModifyPlan(ctx, innerRequest, &innerResponse)
+
+		newPlan = innerResponse.Plan.Raw
+		response.RequiresReplace = innerResponse.RequiresReplace
+		response.Private = innerResponse.Private
+		response.Identity = innerResponse.Identity
+		response.Diagnostics = innerResponse.Diagnostics
+		response.Deferred = innerResponse.Deferred
+	}
+
+	// Destroy plan.
+	if newPlan.IsNull() {
+		return
+	}
+
+	if r.meta != nil && configRegion.IsKnown() && configRegion.IsNull() {
+		if request.State.Raw.IsNull() {
+			// Resource creation: Use the provider-configured Region.
+			planRegion = tftypes.NewValue(tftypes.String, r.meta.DefaultRegion(ctx))
+		} else if regionValueString(stateRegion) != "" {
+			// Resources created before per-resource Region override was introduced have no Region in state.
+			// The value is populated on the next Read.
+			planRegion = tftypes.NewValue(tftypes.String, r.meta.DefaultRegion(ctx))
+		} else {
+			planRegion = stateRegion
+		}
+	} else if !configRegion.IsNull() {
+		planRegion = configRegion
+	}
+
+	// Any change to a known Region forces resource replacement.
+	if !request.State.Raw.IsNull() {
+		if o := regionValueString(stateRegion); o != "" && (!planRegion.IsKnown() || regionValueString(planRegion) != o) {
+			response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+		}
+	}
+
+	response.Plan.Raw, diags = withRegion(newPlan, response.Plan.Schema.Type().TerraformType(ctx), planRegion)
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *regionResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	if v, ok := r.inner.(resource.ResourceWithConfigValidators); ok {
+		var validators []resource.ConfigValidator
+
+		for _, validator := range v.ConfigValidators(ctx) {
+			validators = append(validators, &regionResourceConfigValidator{
+				inner:  validator,
+				schema: r.schema,
+			})
+		}
+
+		return validators
+	}
+
+	return nil
+}
+
+func (r *regionResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	if v, ok := r.inner.(resource.ResourceWithValidateConfig); ok {
+		config, _, diags := withoutRegion(request.Config.Raw)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		v.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Raw: config, Schema: r.schema(ctx)}, ClientCapabilities: request.ClientCapabilities}, response)
+	}
+}
+
+func (r *regionResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	if v, ok := r.inner.(resource.ResourceWithUpgradeState); ok {
+		innerSchema := r.schema(ctx)
+		upgraders := make(map[int64]resource.StateUpgrader)
+
+		for version, upgrader := range v.UpgradeState(ctx) {
+			var priorSchema *rschema.Schema
+			if upgrader.PriorSchema != nil {
+				// Prior state may include the `region` attribute.
+				v := withRegionAttribute(*upgrader.PriorSchema)
+				priorSchema = &v
+			}
+
+			upgraders[version] = resource.StateUpgrader{
+				PriorSchema: priorSchema,
+				StateUpgrader: func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+					region := regionFromRawState(request.RawState)
+					innerRequest := resource.UpgradeStateRequest{
+						RawState: request.RawState,
+					}
+					if request.State != nil {
+						state, v, diags := withoutRegion(request.State.Raw)
+						response.Diagnostics.Append(diags...)
+						if response.Diagnostics.HasError() {
+							return
+						}
+
+						region = v
+						innerRequest.State = &tfsdk.State{Raw: state, Schema: *upgrader.PriorSchema}
+					}
+
+					innerType := innerSchema.Type().TerraformType(ctx)
+					innerResponse := resource.UpgradeStateResponse{
+						State:       tfsdk.State{Raw: tftypes.NewValue(innerType, nil), Schema: innerSchema},
+						Diagnostics: response.Diagnostics,
+					}
+					upgrader.StateUpgrader(ctx, innerRequest, &innerResponse)
+
+					response.Diagnostics = innerResponse.Diagnostics
+					if response.Diagnostics.HasError() {
+						return
+					}
+
+					state := innerResponse.State.Raw
+					if innerResponse.DynamicValue != nil {
+						v, err := innerResponse.DynamicValue.Unmarshal(innerType)
+						if err != nil {
+							response.Diagnostics.AddError("Upgrading resource state", err.Error())
+							return
+						}
+
+						state = v
+					}
+
+					var diags diag.Diagnostics
+					response.State.Raw, diags = withRegion(state, response.State.Schema.Type().TerraformType(ctx), region)
+					response.Diagnostics.Append(diags...)
+				},
+			}
+		}
+
+		return upgraders
+	}
+
+	return nil
+}
+
+func (r *regionResource) MoveState(ctx context.Context) []resource.StateMover {
+	if v, ok := r.inner.(resource.ResourceWithMoveState); ok {
+		innerSchema := r.schema(ctx)
+		var movers []resource.StateMover
+
+		for _, mover := range v.MoveState(ctx) {
+			movers = append(movers, resource.StateMover{
+				SourceSchema: mover.SourceSchema,
+				StateMover: func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+					innerResponse := resource.MoveStateResponse{
+						TargetPrivate:  response.TargetPrivate,
+						TargetIdentity: response.TargetIdentity,
+						Diagnostics:    response.Diagnostics,
+					}
+					mover.StateMover(ctx, request, &innerResponse)
+
+					response.TargetPrivate = innerResponse.TargetPrivate
+					response.TargetIdentity = innerResponse.TargetIdentity
+					response.Diagnostics = innerResponse.Diagnostics
+
+					// No target state indicates that the mover did not match the request.
+					if innerResponse.TargetState.Raw.Type() == nil {
+						return
+					}
+
+					region := regionFromRawState(request.SourceRawState)
+					if request.SourceState != nil {
+						if _, v, _ := withoutRegion(request.SourceState.Raw); regionValueString(v) != "" {
+							region = v
+						}
+					}
+
+					outerSchema := withRegionAttribute(innerSchema)
+					state, diags := withRegion(innerResponse.TargetState.Raw, outerSchema.Type().TerraformType(ctx), region)
+					response.Diagnostics.Append(diags...)
+					response.TargetState = tfsdk.State{Raw: state, Schema: outerSchema}
+				},
+			})
+		}
+
+		return movers
+	}
+
+	return nil
+}
+
+type regionResourceConfigValidator struct {
+	inner  resource.ConfigValidator
+	schema func(context.Context) rschema.Schema
+}
+
+func (v *regionResourceConfigValidator) Description(ctx context.Context) string {
+	return v.inner.Description(ctx)
+}
+
+func (v *regionResourceConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return v.inner.MarkdownDescription(ctx)
+}
+
+func (v *regionResourceConfigValidator) ValidateResource(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	config, _, diags := withoutRegion(request.Config.Raw)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	v.inner.ValidateResource(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Raw: config, Schema: v.schema(ctx)}, ClientCapabilities: request.ClientCapabilities}, response)
+}
+
+// regionListResource implements per-resource Region override for a list resource.
+// The `region` attribute is added to the list resource's configuration schema.
+type regionListResource struct {
+	inner       sdkListResource
+	schemaOnce  sync.Once
+	innerSchema lrschema.Schema
+}
+
+func newRegionListResource(inner sdkListResource) sdkListResource {
+	return &regionListResource{
+		inner: inner,
+	}
+}
+
+func (r *regionListResource) schema(ctx context.Context) lrschema.Schema {
+	r.schemaOnce.Do(func() {
+		response := list.ListResourceSchemaResponse{}
+		r.inner.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &response)
+		r.innerSchema = response.Schema
+	})
+
+	return r.innerSchema
+}
+
+func (r *regionListResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	r.inner.Metadata(ctx, request, response)
+}
+
+func (r *regionListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	r.inner.ListResourceConfigSchema(ctx, request, response)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Schema.Attributes = maps.Clone(response.Schema.Attributes)
+	if response.Schema.Attributes == nil {
+		response.Schema.Attributes = make(map[string]lrschema.Attribute)
+	}
+	response.Schema.Attributes[names.AttrRegion] = regionListResourceAttribute()
+}
+
+func (r *regionListResource) RawV5Schemas(ctx context.Context, request list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	r.inner.RawV5Schemas(ctx, request, response)
+}
+
+func (r *regionListResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	r.inner.Configure(ctx, request, response)
+}
+
+func (r *regionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	config, _, diags := withoutRegion(request.Config.Raw)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	innerRequest := request
+	innerRequest.Config = tfsdk.Config{Raw: config, Schema: r.schema(ctx)}
+	r.inner.List(ctx, innerRequest, stream)
+}
@@ -286,7 +286,20 @@ func setTagsAll(ctx context.Context, meta *conns.AWSClient, request resource.Mod
 	}
 
 	if planTags.IsWhollyKnown() {
-		allTags := meta.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, planTags)).IgnoreConfig(meta.IgnoreTagsConfig(ctx))
+		mergedTags := meta.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, planTags))
+		if policyConfig := meta.TagPolicyConfig(ctx); policyConfig != nil {
+			if err := policyConfig.Validate(mergedTags); err != nil {
+				if policyConfig.IsWarning() {
+					response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), "Tag Policy Violation", err.Error())
+				} else {
+					response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), "Tag Policy Violation", err.Error())
+
+					return
+				}
+			}
+		}
+
+		allTags := mergedTags.IgnoreConfig(meta.IgnoreTagsConfig(ctx))
 		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), fwflex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
 	} else {
 		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.Unknown)...)
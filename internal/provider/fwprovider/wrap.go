@@ -9,11 +9,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
 // Implemented by (Config|Plan|State).GetAttribute().
@@ -248,6 +250,7 @@ type modifyPlanFunc func(context.Context, *conns.AWSClient, resource.ModifyPlanR
 type wrappedResourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
+	identity         *types.ServicePackageResourceIdentity
 	interceptors     resourceInterceptors
 	modifyPlanFuncs  []modifyPlanFunc
 	typeName         string
@@ -261,10 +264,18 @@ type wrappedResource struct {
 }
 
 func newWrappedResource(inner resource.ResourceWithConfigure, opts wrappedResourceOptions) resource.ResourceWithConfigure {
-	return &wrappedResource{
+	w := &wrappedResource{
 		inner: inner,
 		opts:  opts,
 	}
+
+	if opts.identity != nil {
+		return &wrappedResourceWithIdentity{
+			wrappedResource: w,
+		}
+	}
+
+	return w
 }
 
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -354,12 +365,25 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
-		ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+		// When importing by identity any Region override is taken from the identity.
+		var getAttribute getAttributeFunc
+		if request.ID == "" && request.Identity != nil && w.opts.identity != nil && !w.opts.identity.IsGlobalResource && !w.opts.identity.IsARN {
+			getAttribute = request.Identity.GetAttribute
+		}
+
+		ctx, diags := w.opts.bootstrapContext(ctx, getAttribute, w.meta)
 		response.Diagnostics.Append(diags...)
 		if response.Diagnostics.HasError() {
 			return
 		}
 
+		if request.ID == "" && request.Identity != nil && w.opts.identity != nil {
+			response.Diagnostics.Append(validateImportIdentity(ctx, w.meta, w.opts.identity, request.Identity)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
 		v.ImportState(ctx, request, response)
 
 		return
@@ -455,3 +479,98 @@ func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
 
 	return nil
 }
+
+// wrappedResourceWithIdentity represents an interceptor dispatcher for a Plugin Framework resource with resource identity.
+type wrappedResourceWithIdentity struct {
+	*wrappedResource
+}
+
+func (w *wrappedResourceWithIdentity) IdentitySchema(ctx context.Context, request resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	// This method does not call down to the inner resource.
+	response.IdentitySchema = newIdentitySchema(w.opts.identity)
+}
+
+// sdkListResource is implemented by Plugin Framework list resources that list instances of a Plugin SDK v2 resource.
+type sdkListResource interface {
+	list.ListResourceWithConfigure
+	list.ListResourceWithRawV5Schemas
+}
+
+type wrappedListResourceOptions struct {
+	// bootstrapContext is run on all wrapped methods.
+	bootstrapContext contextFunc
+	typeName         string
+}
+
+// wrappedListResource represents a dispatcher for a Plugin Framework list resource.
+type wrappedListResource struct {
+	inner sdkListResource
+	meta  *conns.AWSClient
+	opts  wrappedListResourceOptions
+}
+
+func newWrappedListResource(inner sdkListResource, opts wrappedListResourceOptions) sdkListResource {
+	return &wrappedListResource{
+		inner: inner,
+		opts:  opts,
+	}
+}
+
+func (w *wrappedListResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	// This method does not call down to the inner list resource.
+	response.TypeName = w.opts.typeName
+}
+
+func (w *wrappedListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.ListResourceConfigSchema(ctx, request, response)
+}
+
+func (w *wrappedListResource) RawV5Schemas(ctx context.Context, request list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	w.inner.RawV5Schemas(ctx, request, response)
+}
+
+func (w *wrappedListResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+
+	ctx, diags := w.opts.bootstrapContext(ctx, nil, w.meta)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	ctx, diags := w.opts.bootstrapContext(ctx, request.Config.GetAttribute, w.meta)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	w.inner.List(ctx, request, stream)
+
+	// Stop listing once the requested number of results has been returned.
+	if limit, results := request.Limit, stream.Results; limit > 0 && results != nil {
+		stream.Results = func(yield func(list.ListResult) bool) {
+			var n int64
+			for result := range results {
+				if !yield(result) {
+					return
+				}
+
+				if n++; n >= limit {
+					return
+				}
+			}
+		}
+	}
+}
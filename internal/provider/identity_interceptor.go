@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// newResourceIdentity returns the SDK resource identity for the specified resource identity information.
+func newResourceIdentity(identity *types.ServicePackageResourceIdentity) *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			s := map[string]*schema.Schema{
+				identity.IdentityAttribute: {
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+				},
+			}
+
+			if !identity.IsARN {
+				s[names.AttrAccountID] = &schema.Schema{
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+				}
+
+				if !identity.IsGlobalResource {
+					s[names.AttrRegion] = &schema.Schema{
+						Type:              schema.TypeString,
+						OptionalForImport: true,
+					}
+				}
+			}
+
+			return s
+		},
+	}
+}
+
+// identityInterceptor implements resource identity functionality.
+type identityInterceptor struct {
+	identity *types.ServicePackageResourceIdentity
+}
+
+func newIdentityInterceptor(identity *types.ServicePackageResourceIdentity) interceptor {
+	return &identityInterceptor{
+		identity: identity,
+	}
+}
+
+func (r identityInterceptor) run(ctx context.Context, opts interceptorOptions) diag.Diagnostics {
+	c := opts.c
+	var diags diag.Diagnostics
+
+	switch d, when, why := opts.d, opts.when, opts.why; when {
+	case After:
+		// Set identity in state after CRU.
+		switch why {
+		case Create, Read, Update:
+			rd, ok := d.(*schema.ResourceData)
+			if !ok {
+				break
+			}
+
+			// Resource not found.
+			if rd.Id() == "" {
+				break
+			}
+
+			identity, err := rd.Identity()
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			if !r.identity.IsARN {
+				if err := identity.Set(names.AttrAccountID, c.AccountID(ctx)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "setting identity %s: %s", names.AttrAccountID, err)
+				}
+
+				if !r.identity.IsGlobalResource {
+					if err := identity.Set(names.AttrRegion, c.Region(ctx)); err != nil {
+						return sdkdiag.AppendErrorf(diags, "setting identity %s: %s", names.AttrRegion, err)
+					}
+				}
+			}
+
+			if err := identity.Set(r.identity.IdentityAttribute, identityAttributeValue(rd, r.identity.IdentityAttribute)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting identity %s: %s", r.identity.IdentityAttribute, err)
+			}
+		}
+	}
+
+	return diags
+}
+
+// identityAttributeValue returns the value of the resource's identity attribute.
+func identityAttributeValue(d *schema.ResourceData, name string) string {
+	if name == names.AttrID {
+		return d.Id()
+	}
+
+	return d.Get(name).(string)
+}
+
+// importByIdentity returns an import function that sets the resource's ID from its identity.
+// The function does nothing if the resource is being imported by ID.
+func importByIdentity(identity *types.ServicePackageResourceIdentity, isRegionOverrideEnabled bool) importFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) error {
+		if d.Id() != "" {
+			return nil
+		}
+
+		c := meta.(*conns.AWSClient)
+
+		identityData, err := d.Identity()
+		if err != nil {
+			return err
+		}
+
+		v, ok := identityData.GetOk(identity.IdentityAttribute)
+		if !ok {
+			return fmt.Errorf("identity attribute %q is required", identity.IdentityAttribute)
+		}
+		id := v.(string)
+
+		var region string
+		if identity.IsARN {
+			arn, err := arn.Parse(id)
+			if err != nil {
+				return fmt.Errorf("identity attribute %q: %w", identity.IdentityAttribute, err)
+			}
+
+			if arn.AccountID != "" && arn.AccountID != c.AccountID(ctx) {
+				return fmt.Errorf("identity account ID (%s) does not match the provider-configured account ID (%s)", arn.AccountID, c.AccountID(ctx))
+			}
+
+			region = arn.Region
+		} else {
+			if v, ok := identityData.GetOk(names.AttrAccountID); ok && v.(string) != c.AccountID(ctx) {
+				return fmt.Errorf("identity account ID (%s) does not match the provider-configured account ID (%s)", v.(string), c.AccountID(ctx))
+			}
+
+			if !identity.IsGlobalResource {
+				if v, ok := identityData.GetOk(names.AttrRegion); ok {
+					region = v.(string)
+				}
+			}
+		}
+
+		if region != "" {
+			if isRegionOverrideEnabled {
+				if err := d.Set(names.AttrRegion, region); err != nil {
+					return fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+				}
+			} else if region != c.Region(ctx) {
+				// The resource can only be managed in the provider-configured Region.
+				return fmt.Errorf("identity Region (%s) does not match the provider-configured Region (%s)", region, c.Region(ctx))
+			}
+		}
+
+		d.SetId(id)
+		if identity.IdentityAttribute != names.AttrID {
+			if err := d.Set(identity.IdentityAttribute, id); err != nil {
+				return fmt.Errorf("setting %s: %w", identity.IdentityAttribute, err)
+			}
+		}
+
+		return nil
+	}
+}
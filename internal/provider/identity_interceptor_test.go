@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestNewResourceIdentity(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		identity         *types.ServicePackageResourceIdentity
+		expectedRequired []string
+		expectedOptional []string
+	}{
+		"regional single parameter": {
+			identity:         types.RegionalSingleParameterIdentity(names.AttrName),
+			expectedRequired: []string{names.AttrName},
+			expectedOptional: []string{names.AttrAccountID, names.AttrRegion},
+		},
+		"global single parameter": {
+			identity:         types.GlobalSingleParameterIdentity(names.AttrName),
+			expectedRequired: []string{names.AttrName},
+			expectedOptional: []string{names.AttrAccountID},
+		},
+		"regional ARN": {
+			identity:         types.RegionalARNIdentity(),
+			expectedRequired: []string{names.AttrARN},
+		},
+		"global ARN": {
+			identity:         types.GlobalARNIdentity(),
+			expectedRequired: []string{names.AttrARN},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var required, optional []string
+			for k, v := range newResourceIdentity(testCase.identity).SchemaFunc() {
+				if v.RequiredForImport {
+					required = append(required, k)
+				}
+				if v.OptionalForImport {
+					optional = append(optional, k)
+				}
+			}
+			slices.Sort(required)
+			slices.Sort(optional)
+
+			if diff := cmp.Diff(required, testCase.expectedRequired); diff != "" {
+				t.Errorf("unexpected RequiredForImport attributes difference: %s", diff)
+			}
+			if diff := cmp.Diff(optional, testCase.expectedOptional); diff != "" {
+				t.Errorf("unexpected OptionalForImport attributes difference: %s", diff)
+			}
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -55,6 +56,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"audit_log_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path of a file to which a JSON Lines audit trail of AWS API calls is appended. " +
+					"Can also be configured using the `" + conns.AuditLogPathEnvVar + "` environment variable.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -159,6 +166,39 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limit": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration block with client-side rate limiting settings for a service or a single operation of a service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_in_flight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Maximum number of concurrent requests.",
+						},
+						"operation": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "API operation name, for example `ChangeResourceRecordSets`. " +
+								"If not set, the limits apply to all of the service's operations.",
+						},
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0.001),
+							Description:  "Maximum sustained rate at which requests are sent.",
+						},
+						"service": {
+							Type:     schema.TypeString,
+							Required: true,
+							Description: "Service name, as used in the `endpoints` configuration block, " +
+								"for example `route53`.",
+						},
+					},
+				},
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -235,6 +275,41 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to validate resource tags against a tag policy at plan time.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"required_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tag keys that must be present on all tagged resources.",
+						},
+						"severity": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[tftags.PolicySeverity](),
+							Description: "Severity of the diagnostic reported for tag policy violations. " +
+								"Valid values are `error` and `warning`. Defaults to `error`.",
+						},
+						"use_organizations_policy": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Description: "Load the account's effective AWS Organizations tag policy " +
+								"and validate tag key capitalization and allowed values against it.",
+						},
+						"value_patterns": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Map of resource tag keys to regular expressions that the tag values must match.",
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -293,6 +368,22 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 
 			interceptors := interceptorItems{}
+			isRegionOverrideEnabled := v.Region != nil && v.Region.IsOverrideEnabled
+			if isRegionOverrideEnabled {
+				// The data source supports per-resource Region override.
+				// Ensure that the schema does not already define the attribute.
+				if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s", names.AttrRegion, typeName))
+					continue
+				}
+
+				injectRegionAttribute(r)
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Read,
+					interceptor: newRegionInterceptor(),
+				})
+			}
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -316,13 +407,20 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 
 			opts := wrappedDataSourceOptions{
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
-					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
-					if v, ok := meta.(*conns.AWSClient); ok {
-						ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
-						ctx = v.RegisterLogger(ctx)
+					overrideRegion := overrideRegionFromAttribute(isRegionOverrideEnabled, getAttribute)
+					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName, overrideRegion)
+					if c, ok := meta.(*conns.AWSClient); ok {
+						if isRegionOverrideEnabled && v.Region.IsValidateOverrideInPartition {
+							if err := c.ValidateInContextRegionInPartition(ctx); err != nil {
+								return ctx, sdkdiag.AppendFromErr(diags, err)
+							}
+						}
+
+						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
+						ctx = c.RegisterLogger(ctx)
 					}
 
 					return ctx, diags
@@ -363,7 +461,46 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 
 			var customizeDiffFuncs []schema.CustomizeDiffFunc
+			var importFuncs []importFunc
 			interceptors := interceptorItems{}
+			isRegionOverrideEnabled := v.Region != nil && v.Region.IsOverrideEnabled
+			if isRegionOverrideEnabled {
+				// The resource supports per-resource Region override.
+				// Ensure that the schema does not already define the attribute.
+				if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s", names.AttrRegion, typeName))
+					continue
+				}
+
+				injectRegionAttribute(r)
+				customizeDiffFuncs = append(customizeDiffFuncs, setRegionInPlan)
+				if r.Importer != nil {
+					importFuncs = append(importFuncs, importRegion)
+				}
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Create | Read | Update,
+					interceptor: newRegionInterceptor(),
+				})
+			}
+			if v := v.Identity; v != nil {
+				// The resource has opted in to resource identity.
+				// Ensure that the schema defines the identity attribute.
+				if _, ok := r.SchemaMap()[v.IdentityAttribute]; !ok && v.IdentityAttribute != names.AttrID {
+					errs = append(errs, fmt.Errorf("no `%s` identity attribute defined in schema: %s", v.IdentityAttribute, typeName))
+					continue
+				}
+
+				r.Identity = newResourceIdentity(v)
+				if r.Importer != nil {
+					importFuncs = append(importFuncs, importByIdentity(v, isRegionOverrideEnabled))
+				}
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Create | Read | Update,
+					interceptor: newIdentityInterceptor(v),
+				})
+			}
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -398,18 +535,26 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			opts := wrappedResourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
-					if v, ok := meta.(*conns.AWSClient); ok {
-						ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
-						ctx = v.RegisterLogger(ctx)
+					overrideRegion := overrideRegionFromAttribute(isRegionOverrideEnabled, getAttribute)
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName, overrideRegion)
+					if c, ok := meta.(*conns.AWSClient); ok {
+						if isRegionOverrideEnabled && v.Region.IsValidateOverrideInPartition {
+							if err := c.ValidateInContextRegionInPartition(ctx); err != nil {
+								return ctx, sdkdiag.AppendFromErr(diags, err)
+							}
+						}
+
+						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
+						ctx = c.RegisterLogger(ctx)
 					}
 
 					return ctx, diags
 				},
 				customizeDiffFuncs: customizeDiffFuncs,
+				importFuncs:        importFuncs,
 				interceptors:       interceptors,
 				typeName:           typeName,
 			}
@@ -471,6 +616,12 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
 	}
 
+	if v, ok := d.Get("audit_log_path").(string); ok && v != "" {
+		config.AuditLogPath = v
+	} else {
+		config.AuditLogPath = os.Getenv(conns.AuditLogPathEnvVar)
+	}
+
 	if v, ok := d.Get("retry_mode").(string); ok && v != "" {
 		mode, err := aws.ParseRetryMode(v)
 		if err != nil {
@@ -567,10 +718,26 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		policyConfig, err := expandTagPolicy(v.([]any)[0].(map[string]any))
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "tag_policy: %s", err)
+		}
+		config.TagPolicyConfig = policyConfig
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("rate_limit"); ok && len(v.([]any)) > 0 {
+		rateLimits, err := expandRateLimits(v.([]any))
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "rate_limit: %s", err)
+		}
+		config.RateLimits = rateLimits
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]any)) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]any))
 	}
@@ -909,6 +1076,75 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 	return ignoreConfig
 }
 
+func expandTagPolicy(tfMap map[string]any) (*tftags.PolicyConfig, error) {
+	var requiredKeys []string
+	if v, ok := tfMap["required_keys"].(*schema.Set); ok && v.Len() > 0 {
+		requiredKeys = flex.ExpandStringValueSet(v)
+	}
+
+	var valuePatterns map[string]string
+	if v, ok := tfMap["value_patterns"].(map[string]any); ok && len(v) > 0 {
+		valuePatterns = flex.ExpandStringValueMap(v)
+	}
+
+	var severity tftags.PolicySeverity
+	if v, ok := tfMap["severity"].(string); ok && v != "" {
+		severity = tftags.PolicySeverity(v)
+	}
+
+	policyConfig, err := tftags.NewPolicyConfig(requiredKeys, valuePatterns, severity)
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := tfMap["use_organizations_policy"].(bool); ok {
+		policyConfig.UseEffectivePolicy = v
+	}
+
+	return policyConfig, nil
+}
+
+func expandRateLimits(tfList []any) ([]conns.RateLimit, error) {
+	var rateLimits []conns.RateLimit
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		var rateLimit conns.RateLimit
+
+		if v, ok := tfMap["service"].(string); ok && v != "" {
+			pkg, err := names.ProviderPackageForAlias(v)
+			if err != nil {
+				return nil, err
+			}
+			rateLimit.Service = pkg
+		}
+
+		if v, ok := tfMap["operation"].(string); ok {
+			rateLimit.Operation = v
+		}
+
+		if v, ok := tfMap["requests_per_second"].(float64); ok {
+			rateLimit.RequestsPerSecond = v
+		}
+
+		if v, ok := tfMap["max_in_flight"].(int); ok {
+			rateLimit.MaxInFlight = v
+		}
+
+		if rateLimit.RequestsPerSecond <= 0 && rateLimit.MaxInFlight <= 0 {
+			return nil, fmt.Errorf("one of `requests_per_second` or `max_in_flight` must be set for service %q", rateLimit.Service)
+		}
+
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	return rateLimits, nil
+}
+
 func DeprecatedEnvVarDiag(envvar, replacement string) diag.Diagnostic {
 	return errs.NewWarningDiagnostic(
 		"Deprecated Environment Variable",
@@ -5,7 +5,9 @@ package provider
 
 import (
 	"context"
+	"maps"
 	"os"
+	"slices"
 	"strings"
 	"testing"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -424,6 +427,133 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		tfMap            map[string]any
+		expectedSeverity tftags.PolicySeverity
+		expectedKeys     []string
+		expectedErr      bool
+	}{
+		"empty": {
+			tfMap:            map[string]any{},
+			expectedSeverity: tftags.PolicySeverityError,
+		},
+		"config": {
+			tfMap: map[string]any{
+				"required_keys": schema.NewSet(schema.HashString, []any{"CostCenter", "Owner"}),
+				"severity":      "warning",
+				"value_patterns": map[string]any{
+					"CostCenter": `^[0-9]+$`,
+				},
+			},
+			expectedSeverity: tftags.PolicySeverityWarning,
+			expectedKeys:     []string{"costcenter", "owner"},
+		},
+		"invalid pattern": {
+			tfMap: map[string]any{
+				"value_patterns": map[string]any{
+					"CostCenter": `[`,
+				},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, err := expandTagPolicy(testcase.tfMap)
+
+			if got, want := err != nil, testcase.expectedErr; got != want {
+				t.Fatalf("expandTagPolicy() err %t, want %t", got, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if got, want := results.Severity, testcase.expectedSeverity; got != want {
+				t.Errorf("Severity = %q, want %q", got, want)
+			}
+			if diff := cmp.Diff(testcase.expectedKeys, slices.Sorted(maps.Keys(results.Keys))); diff != "" {
+				t.Errorf("Unexpected keys diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandRateLimits(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		tfList      []any
+		expected    []conns.RateLimit
+		expectedErr bool
+	}{
+		"empty": {
+			tfList: []any{},
+		},
+		"service and operation": {
+			tfList: []any{
+				map[string]any{
+					"service":             "route53",
+					"operation":           "",
+					"requests_per_second": 5.0,
+					"max_in_flight":       0,
+				},
+				map[string]any{
+					"service":             "transcribeservice",
+					"operation":           "StartTranscriptionJob",
+					"requests_per_second": 0.0,
+					"max_in_flight":       2,
+				},
+			},
+			expected: []conns.RateLimit{
+				{Service: names.Route53, RequestsPerSecond: 5},
+				{Service: names.Transcribe, Operation: "StartTranscriptionJob", MaxInFlight: 2},
+			},
+		},
+		"unknown service": {
+			tfList: []any{
+				map[string]any{
+					"service":       "notaservice",
+					"max_in_flight": 1,
+				},
+			},
+			expectedErr: true,
+		},
+		"no limits": {
+			tfList: []any{
+				map[string]any{
+					"service": "iam",
+				},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, err := expandRateLimits(testcase.tfList)
+
+			if got, want := err != nil, testcase.expectedErr; got != want {
+				t.Fatalf("expandRateLimits() err %t, want %t", got, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(testcase.expected, results); diff != "" {
+				t.Errorf("Unexpected diff: %s", diff)
+			}
+		})
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
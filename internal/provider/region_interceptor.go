@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// regionSchema returns the schema for the top-level `region` attribute injected into regional resources and data sources.
+func regionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  names.TopLevelRegionAttributeDescription,
+	}
+}
+
+// injectRegionAttribute adds the top-level `region` attribute to the resource's schema.
+func injectRegionAttribute(r *schema.Resource) {
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			s := maps.Clone(f())
+			s[names.AttrRegion] = regionSchema()
+			return s
+		}
+	} else {
+		// Some resources share a package-level schema map. Don't modify it in place.
+		s := maps.Clone(r.Schema)
+		s[names.AttrRegion] = regionSchema()
+		r.Schema = s
+	}
+}
+
+// overrideRegionFromAttribute returns any per-resource Region override value from the `region` attribute.
+func overrideRegionFromAttribute(isRegionOverrideEnabled bool, getAttribute getAttributeFunc) string {
+	if isRegionOverrideEnabled && getAttribute != nil {
+		if v, ok := getAttribute(names.AttrRegion); ok {
+			return v.(string)
+		}
+	}
+
+	return ""
+}
+
+// regionInterceptor implements per-resource Region override functionality.
+type regionInterceptor struct{}
+
+func newRegionInterceptor() interceptor {
+	return &regionInterceptor{}
+}
+
+func (r regionInterceptor) run(ctx context.Context, opts interceptorOptions) diag.Diagnostics {
+	c := opts.c
+	var diags diag.Diagnostics
+
+	switch d, when, why := opts.d, opts.when, opts.why; when {
+	case After:
+		// Set region in state after CRU.
+		switch why {
+		case Create, Read, Update:
+			if err := d.Set(names.AttrRegion, c.Region(ctx)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return diags
+}
+
+// setRegionInPlan is a CustomizeDiff function that calculates the new value for the `region` attribute.
+// If no value is configured the provider-configured Region is used.
+// Any change to a known Region forces resource replacement.
+func setRegionInPlan(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	c := meta.(*conns.AWSClient)
+
+	if config := d.GetRawConfig(); !config.IsNull() && config.IsKnown() {
+		if v := config.GetAttr(names.AttrRegion); v.IsKnown() && v.IsNull() {
+			// Resources created before per-resource Region override was introduced have no Region in state.
+			// The value is populated on the next Read.
+			if o, _ := d.GetChange(names.AttrRegion); d.Id() == "" || o.(string) != "" {
+				if err := d.SetNew(names.AttrRegion, c.DefaultRegion(ctx)); err != nil {
+					return fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+				}
+			}
+		}
+	}
+
+	if d.Id() != "" && d.HasChange(names.AttrRegion) {
+		if o, _ := d.GetChange(names.AttrRegion); o.(string) != "" {
+			if err := d.ForceNew(names.AttrRegion); err != nil {
+				return fmt.Errorf("forcing new %s: %w", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// importRegion is an import function that sets the `region` attribute from the import ID.
+// The import ID may be suffixed with `@<region>` to import a resource in a Region other than the provider-configured Region,
+// otherwise if the import ID is a regional ARN its Region is used.
+func importRegion(ctx context.Context, d *schema.ResourceData, meta any) error {
+	c := meta.(*conns.AWSClient)
+	region := c.DefaultRegion(ctx)
+
+	if id := d.Id(); strings.Contains(id, "@") {
+		if i := strings.LastIndex(id, "@"); types.IsAWSRegion(id[i+1:]) {
+			d.SetId(id[:i])
+			region = id[i+1:]
+		}
+	} else if v, err := arn.Parse(id); err == nil && v.Region != "" {
+		region = v.Region
+	}
+
+	if err := d.Set(names.AttrRegion, region); err != nil {
+		return fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -57,6 +58,15 @@ func (r tagsResourceInterceptor) run(ctx context.Context, opts interceptorOption
 
 			tagsInContext.TagsIn = option.Some(tags)
 
+			// Tag policy violations with error severity are reported at plan time by setTagsAll.
+			// The Plugin SDK cannot return warnings from a plan, so those with warning severity
+			// are only logged by setTagsAll and are reported as diagnostics here, during apply.
+			if policyConfig := c.TagPolicyConfig(ctx); policyConfig.IsWarning() {
+				if err := policyConfig.Validate(tags); err != nil {
+					diags = sdkdiag.AppendWarningf(diags, "tag policy violation for %s %s: %s", serviceName, resourceName, err)
+				}
+			}
+
 			if why == Create {
 				break
 			}
@@ -275,7 +285,19 @@ func setTagsAll(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 	}
 
 	newTags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))
-	allTags := c.DefaultTagsConfig(ctx).MergeTags(newTags).IgnoreConfig(c.IgnoreTagsConfig(ctx))
+	mergedTags := c.DefaultTagsConfig(ctx).MergeTags(newTags)
+	if policyConfig := c.TagPolicyConfig(ctx); policyConfig.IsWarning() {
+		// A CustomizeDiff function cannot return warning diagnostics, so the violation is logged here
+		// and reported as a warning diagnostic during apply by the resource interceptor.
+		if err := policyConfig.Validate(mergedTags); err != nil {
+			tflog.Warn(ctx, "tag policy violation", map[string]any{
+				"error": err.Error(),
+			})
+		}
+	} else if err := policyConfig.Validate(mergedTags); err != nil {
+		return fmt.Errorf("tag policy violation: %w", err)
+	}
+	allTags := mergedTags.IgnoreConfig(c.IgnoreTagsConfig(ctx))
 	if d.HasChange(names.AttrTags) {
 		if newTags.HasZeroValue() {
 			if err := d.SetNewComputed(names.AttrTagsAll); err != nil {
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_accessanalyzer_check_access_not_granted", name="Check Access Not Granted")
+func newCheckAccessNotGrantedDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &checkAccessNotGrantedDataSource{}, nil
+}
+
+type checkAccessNotGrantedDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *checkAccessNotGrantedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"error_on_failure": schema.BoolAttribute{
+				Optional: true,
+			},
+			names.AttrMessage: schema.StringAttribute{
+				Computed: true,
+			},
+			"policy_document": schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Required:   true,
+			},
+			"policy_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AccessCheckPolicyType](),
+				Required:   true,
+			},
+			"reasons": framework.DataSourceComputedListOfObjectAttribute[reasonSummaryModel](ctx),
+			"result": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CheckAccessNotGrantedResult](),
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"access": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[accessModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrActions: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						names.AttrResources: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *checkAccessNotGrantedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data checkAccessNotGrantedDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().AccessAnalyzerClient(ctx)
+
+	var input accessanalyzer.CheckAccessNotGrantedInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CheckAccessNotGranted(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("checking IAM Access Analyzer access not granted", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ErrorOnFailure.ValueBool() && output.Result == awstypes.CheckAccessNotGrantedResultFail {
+		response.Diagnostics.AddError("IAM Access Analyzer access not granted check failed", customPolicyCheckFailureDetail(output.Message, output.Reasons))
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type checkAccessNotGrantedDataSourceModel struct {
+	Access         fwtypes.ListNestedObjectValueOf[accessModel]             `tfsdk:"access"`
+	ErrorOnFailure types.Bool                                               `tfsdk:"error_on_failure"`
+	Message        types.String                                             `tfsdk:"message"`
+	PolicyDocument fwtypes.IAMPolicy                                        `tfsdk:"policy_document"`
+	PolicyType     fwtypes.StringEnum[awstypes.AccessCheckPolicyType]       `tfsdk:"policy_type"`
+	Reasons        fwtypes.ListNestedObjectValueOf[reasonSummaryModel]      `tfsdk:"reasons"`
+	Result         fwtypes.StringEnum[awstypes.CheckAccessNotGrantedResult] `tfsdk:"result"`
+}
+
+type accessModel struct {
+	Actions   fwtypes.SetOfString `tfsdk:"actions"`
+	Resources fwtypes.SetOfString `tfsdk:"resources"`
+}
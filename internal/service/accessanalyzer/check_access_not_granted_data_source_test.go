@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAccessAnalyzerCheckAccessNotGrantedDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_check_access_not_granted.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAccessNotGrantedDataSourceConfig_basic("s3:GetObject", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrMessage),
+					resource.TestCheckResourceAttr(dataSourceName, "reasons.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "result", string(awstypes.CheckAccessNotGrantedResultPass)),
+				),
+			},
+			{
+				Config: testAccCheckAccessNotGrantedDataSourceConfig_basic("s3:DeleteBucket", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrMessage),
+					resource.TestCheckResourceAttr(dataSourceName, "reasons.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "result", string(awstypes.CheckAccessNotGrantedResultFail)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessAnalyzerCheckAccessNotGrantedDataSource_errorOnFailure(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckAccessNotGrantedDataSourceConfig_basic("s3:DeleteBucket", true),
+				ExpectError: regexache.MustCompile(`IAM Access Analyzer access not granted check failed`),
+			},
+		},
+	})
+}
+
+func testAccCheckAccessNotGrantedDataSourceConfig_basic(action string, errorOnFailure bool) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:*"]
+    resources = ["*"]
+  }
+}
+
+data "aws_accessanalyzer_check_access_not_granted" "test" {
+  policy_document  = data.aws_iam_policy_document.test.json
+  policy_type      = "IDENTITY_POLICY"
+  error_on_failure = %[2]t
+
+  access {
+    actions = [%[1]q]
+  }
+}
+`, action, errorOnFailure)
+}
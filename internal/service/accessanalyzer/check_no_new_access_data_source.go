@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_accessanalyzer_check_no_new_access", name="Check No New Access")
+func newCheckNoNewAccessDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &checkNoNewAccessDataSource{}, nil
+}
+
+type checkNoNewAccessDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *checkNoNewAccessDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"error_on_failure": schema.BoolAttribute{
+				Optional: true,
+			},
+			"existing_policy_document": schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Required:   true,
+			},
+			names.AttrMessage: schema.StringAttribute{
+				Computed: true,
+			},
+			"new_policy_document": schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Required:   true,
+			},
+			"policy_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AccessCheckPolicyType](),
+				Required:   true,
+			},
+			"reasons": framework.DataSourceComputedListOfObjectAttribute[reasonSummaryModel](ctx),
+			"result": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CheckNoNewAccessResult](),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *checkNoNewAccessDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data checkNoNewAccessDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().AccessAnalyzerClient(ctx)
+
+	var input accessanalyzer.CheckNoNewAccessInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CheckNoNewAccess(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("checking IAM Access Analyzer no new access", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ErrorOnFailure.ValueBool() && output.Result == awstypes.CheckNoNewAccessResultFail {
+		response.Diagnostics.AddError("IAM Access Analyzer no new access check failed", customPolicyCheckFailureDetail(output.Message, output.Reasons))
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// customPolicyCheckFailureDetail returns a human-readable description of a failed custom policy check.
+func customPolicyCheckFailureDetail(message *string, reasons []awstypes.ReasonSummary) string {
+	var sb strings.Builder
+
+	sb.WriteString(aws.ToString(message))
+
+	for _, reason := range reasons {
+		sb.WriteString("\n  - ")
+		sb.WriteString(aws.ToString(reason.Description))
+
+		if v := aws.ToString(reason.StatementId); v != "" {
+			fmt.Fprintf(&sb, " (statement %q)", v)
+		} else if reason.StatementIndex != nil {
+			fmt.Fprintf(&sb, " (statement index %d)", aws.ToInt32(reason.StatementIndex))
+		}
+	}
+
+	return sb.String()
+}
+
+type checkNoNewAccessDataSourceModel struct {
+	ErrorOnFailure         types.Bool                                          `tfsdk:"error_on_failure"`
+	ExistingPolicyDocument fwtypes.IAMPolicy                                   `tfsdk:"existing_policy_document"`
+	Message                types.String                                        `tfsdk:"message"`
+	NewPolicyDocument      fwtypes.IAMPolicy                                   `tfsdk:"new_policy_document"`
+	PolicyType             fwtypes.StringEnum[awstypes.AccessCheckPolicyType]  `tfsdk:"policy_type"`
+	Reasons                fwtypes.ListNestedObjectValueOf[reasonSummaryModel] `tfsdk:"reasons"`
+	Result                 fwtypes.StringEnum[awstypes.CheckNoNewAccessResult] `tfsdk:"result"`
+}
+
+type reasonSummaryModel struct {
+	Description    types.String `tfsdk:"description"`
+	StatementID    types.String `tfsdk:"statement_id"`
+	StatementIndex types.Int32  `tfsdk:"statement_index"`
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAccessAnalyzerCheckNoNewAccessDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_check_no_new_access.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNoNewAccessDataSourceConfig_basic(`["s3:GetObject"]`, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrMessage),
+					resource.TestCheckResourceAttr(dataSourceName, "reasons.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "result", string(awstypes.CheckNoNewAccessResultPass)),
+				),
+			},
+			{
+				Config: testAccCheckNoNewAccessDataSourceConfig_basic(`["s3:GetObject", "s3:PutObject"]`, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrMessage),
+					resource.TestCheckResourceAttr(dataSourceName, "reasons.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "reasons.0.statement_index", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "result", string(awstypes.CheckNoNewAccessResultFail)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessAnalyzerCheckNoNewAccessDataSource_errorOnFailure(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckNoNewAccessDataSourceConfig_basic(`["s3:GetObject", "s3:PutObject"]`, true),
+				ExpectError: regexache.MustCompile(`IAM Access Analyzer no new access check failed`),
+			},
+		},
+	})
+}
+
+func testAccCheckNoNewAccessDataSourceConfig_basic(newActions string, errorOnFailure bool) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "existing" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["arn:${data.aws_partition.current.partition}:s3:::example-bucket/*"]
+  }
+}
+
+data "aws_iam_policy_document" "new" {
+  statement {
+    actions   = %[1]s
+    resources = ["arn:${data.aws_partition.current.partition}:s3:::example-bucket/*"]
+  }
+}
+
+data "aws_accessanalyzer_check_no_new_access" "test" {
+  existing_policy_document = data.aws_iam_policy_document.existing.json
+  new_policy_document      = data.aws_iam_policy_document.new.json
+  policy_type              = "IDENTITY_POLICY"
+  error_on_failure         = %[2]t
+}
+`, newActions, errorOnFailure)
+}
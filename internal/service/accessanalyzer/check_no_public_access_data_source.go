@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_accessanalyzer_check_no_public_access", name="Check No Public Access")
+func newCheckNoPublicAccessDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &checkNoPublicAccessDataSource{}, nil
+}
+
+type checkNoPublicAccessDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *checkNoPublicAccessDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"error_on_failure": schema.BoolAttribute{
+				Optional: true,
+			},
+			names.AttrMessage: schema.StringAttribute{
+				Computed: true,
+			},
+			"policy_document": schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Required:   true,
+			},
+			"reasons": framework.DataSourceComputedListOfObjectAttribute[reasonSummaryModel](ctx),
+			names.AttrResourceType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AccessCheckResourceType](),
+				Required:   true,
+			},
+			"result": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CheckNoPublicAccessResult](),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *checkNoPublicAccessDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data checkNoPublicAccessDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().AccessAnalyzerClient(ctx)
+
+	var input accessanalyzer.CheckNoPublicAccessInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CheckNoPublicAccess(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("checking IAM Access Analyzer no public access", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ErrorOnFailure.ValueBool() && output.Result == awstypes.CheckNoPublicAccessResultFail {
+		response.Diagnostics.AddError("IAM Access Analyzer no public access check failed", customPolicyCheckFailureDetail(output.Message, output.Reasons))
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type checkNoPublicAccessDataSourceModel struct {
+	ErrorOnFailure types.Bool                                             `tfsdk:"error_on_failure"`
+	Message        types.String                                           `tfsdk:"message"`
+	PolicyDocument fwtypes.IAMPolicy                                      `tfsdk:"policy_document"`
+	Reasons        fwtypes.ListNestedObjectValueOf[reasonSummaryModel]    `tfsdk:"reasons"`
+	ResourceType   fwtypes.StringEnum[awstypes.AccessCheckResourceType]   `tfsdk:"resource_type"`
+	Result         fwtypes.StringEnum[awstypes.CheckNoPublicAccessResult] `tfsdk:"result"`
+}
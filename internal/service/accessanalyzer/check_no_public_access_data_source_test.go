@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAccessAnalyzerCheckNoPublicAccessDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_check_no_public_access.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNoPublicAccessDataSourceConfig_basic("data.aws_caller_identity.current.account_id", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrMessage),
+					resource.TestCheckResourceAttr(dataSourceName, "reasons.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "result", string(awstypes.CheckNoPublicAccessResultPass)),
+				),
+			},
+			{
+				Config: testAccCheckNoPublicAccessDataSourceConfig_basic(`"*"`, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrMessage),
+					resource.TestCheckResourceAttr(dataSourceName, "reasons.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "result", string(awstypes.CheckNoPublicAccessResultFail)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessAnalyzerCheckNoPublicAccessDataSource_errorOnFailure(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckNoPublicAccessDataSourceConfig_basic(`"*"`, true),
+				ExpectError: regexache.MustCompile(`IAM Access Analyzer no public access check failed`),
+			},
+		},
+	})
+}
+
+func testAccCheckNoPublicAccessDataSourceConfig_basic(principal string, errorOnFailure bool) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["arn:${data.aws_partition.current.partition}:s3:::example-bucket/*"]
+
+    principals {
+      type        = "AWS"
+      identifiers = [%[1]s]
+    }
+  }
+}
+
+data "aws_accessanalyzer_check_no_public_access" "test" {
+  policy_document  = data.aws_iam_policy_document.test.json
+  resource_type    = "AWS::S3::Bucket"
+  error_on_failure = %[2]t
+}
+`, principal, errorOnFailure)
+}
@@ -5,9 +5,11 @@ package accessanalyzer
 
 // Exports for use in tests only.
 var (
-	ArchiveRuleParseResourceID  = archiveRuleParseResourceID
-	FindAnalyzerByName          = findAnalyzerByName
-	FindArchiveRuleByTwoPartKey = findArchiveRuleByTwoPartKey
+	ArchiveRuleParseResourceID       = archiveRuleParseResourceID
+	FindAnalyzerByName               = findAnalyzerByName
+	FindArchiveRuleByTwoPartKey      = findArchiveRuleByTwoPartKey
+	FlattenPathElements              = flattenPathElements
+	ValidatePolicyFindingTypeAtLeast = validatePolicyFindingTypeAtLeast
 
 	ResourceAnalyzer    = resourceAnalyzer
 	ResourceArchiveRule = resourceArchiveRule
@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// @FrameworkDataSource("aws_accessanalyzer_policy_validation", name="Policy Validation")
+func newPolicyValidationDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &policyValidationDataSource{}, nil
+}
+
+type policyValidationDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *policyValidationDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"fail_on_finding_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ValidatePolicyFindingType](),
+				Optional:   true,
+			},
+			"findings": framework.DataSourceComputedListOfObjectAttribute[validatePolicyFindingModel](ctx),
+			"locale": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Locale](),
+				Optional:   true,
+			},
+			"policy_document": schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Required:   true,
+			},
+			"policy_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PolicyType](),
+				Required:   true,
+			},
+			"validate_policy_resource_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ValidatePolicyResourceType](),
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (d *policyValidationDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data policyValidationDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().AccessAnalyzerClient(ctx)
+
+	var input accessanalyzer.ValidatePolicyInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	findings, err := validatePolicy(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("validating IAM Access Analyzer policy", err.Error())
+
+		return
+	}
+
+	data.Findings = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, flattenValidatePolicyFindings(ctx, findings))
+
+	if threshold := data.FailOnFindingType.ValueEnum(); threshold != "" {
+		var failed []string
+
+		for _, finding := range findings {
+			if validatePolicyFindingTypeAtLeast(finding.FindingType, threshold) {
+				failed = append(failed, fmt.Sprintf("  - %s: %s (%s)", finding.FindingType, aws.ToString(finding.FindingDetails), aws.ToString(finding.IssueCode)))
+			}
+		}
+
+		if len(failed) > 0 {
+			response.Diagnostics.AddError(fmt.Sprintf("IAM Access Analyzer policy validation returned %d finding(s) of type %s or more severe", len(failed), threshold), strings.Join(failed, "\n"))
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func validatePolicy(ctx context.Context, conn *accessanalyzer.Client, input *accessanalyzer.ValidatePolicyInput) ([]awstypes.ValidatePolicyFinding, error) {
+	var output []awstypes.ValidatePolicyFinding
+
+	pages := accessanalyzer.NewValidatePolicyPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Findings...)
+	}
+
+	return output, nil
+}
+
+// validatePolicyFindingTypes lists the policy validation finding types from most to least severe.
+var validatePolicyFindingTypes = []awstypes.ValidatePolicyFindingType{
+	awstypes.ValidatePolicyFindingTypeError,
+	awstypes.ValidatePolicyFindingTypeSecurityWarning,
+	awstypes.ValidatePolicyFindingTypeWarning,
+	awstypes.ValidatePolicyFindingTypeSuggestion,
+}
+
+// validatePolicyFindingTypeAtLeast returns whether the finding type is at least as severe as the threshold.
+func validatePolicyFindingTypeAtLeast(findingType, threshold awstypes.ValidatePolicyFindingType) bool {
+	i, j := slices.Index(validatePolicyFindingTypes, findingType), slices.Index(validatePolicyFindingTypes, threshold)
+
+	return i != -1 && j != -1 && i <= j
+}
+
+func flattenValidatePolicyFindings(ctx context.Context, apiObjects []awstypes.ValidatePolicyFinding) []validatePolicyFindingModel {
+	findings := make([]validatePolicyFindingModel, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		locations := make([]locationModel, 0, len(apiObject.Locations))
+
+		for _, location := range apiObject.Locations {
+			v := locationModel{
+				Path: types.StringValue(flattenPathElements(location.Path)),
+				Span: fwtypes.NewListNestedObjectValueOfNull[spanModel](ctx),
+			}
+
+			if span := location.Span; span != nil {
+				v.Span = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []spanModel{{
+					End:   flattenPosition(ctx, span.End),
+					Start: flattenPosition(ctx, span.Start),
+				}})
+			}
+
+			locations = append(locations, v)
+		}
+
+		findings = append(findings, validatePolicyFindingModel{
+			FindingDetails: fwflex.StringToFramework(ctx, apiObject.FindingDetails),
+			FindingType:    fwtypes.StringEnumValue(apiObject.FindingType),
+			IssueCode:      fwflex.StringToFramework(ctx, apiObject.IssueCode),
+			LearnMoreLink:  fwflex.StringToFramework(ctx, apiObject.LearnMoreLink),
+			Locations:      fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, locations),
+		})
+	}
+
+	return findings
+}
+
+// flattenPathElements renders a policy location path as a string, e.g. `Statement[0].Action[1]`.
+func flattenPathElements(apiObjects []awstypes.PathElement) string {
+	var sb strings.Builder
+
+	for _, apiObject := range apiObjects {
+		switch v := apiObject.(type) {
+		case *awstypes.PathElementMemberIndex:
+			fmt.Fprintf(&sb, "[%d]", v.Value)
+		case *awstypes.PathElementMemberKey:
+			if sb.Len() > 0 {
+				sb.WriteString(".")
+			}
+			sb.WriteString(v.Value)
+		case *awstypes.PathElementMemberSubstring:
+			start := aws.ToInt32(v.Value.Start)
+			fmt.Fprintf(&sb, "[%d:%d]", start, start+aws.ToInt32(v.Value.Length))
+		case *awstypes.PathElementMemberValue:
+			fmt.Fprintf(&sb, "[%q]", v.Value)
+		}
+	}
+
+	return sb.String()
+}
+
+func flattenPosition(ctx context.Context, apiObject *awstypes.Position) fwtypes.ListNestedObjectValueOf[positionModel] {
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[positionModel](ctx)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []positionModel{{
+		Column: types.Int32PointerValue(apiObject.Column),
+		Line:   types.Int32PointerValue(apiObject.Line),
+		Offset: types.Int32PointerValue(apiObject.Offset),
+	}})
+}
+
+type policyValidationDataSourceModel struct {
+	FailOnFindingType          fwtypes.StringEnum[awstypes.ValidatePolicyFindingType]      `tfsdk:"fail_on_finding_type"`
+	Findings                   fwtypes.ListNestedObjectValueOf[validatePolicyFindingModel] `tfsdk:"findings"`
+	Locale                     fwtypes.StringEnum[awstypes.Locale]                         `tfsdk:"locale"`
+	PolicyDocument             fwtypes.IAMPolicy                                           `tfsdk:"policy_document"`
+	PolicyType                 fwtypes.StringEnum[awstypes.PolicyType]                     `tfsdk:"policy_type"`
+	ValidatePolicyResourceType fwtypes.StringEnum[awstypes.ValidatePolicyResourceType]     `tfsdk:"validate_policy_resource_type"`
+}
+
+type validatePolicyFindingModel struct {
+	FindingDetails types.String                                           `tfsdk:"finding_details"`
+	FindingType    fwtypes.StringEnum[awstypes.ValidatePolicyFindingType] `tfsdk:"finding_type"`
+	IssueCode      types.String                                           `tfsdk:"issue_code"`
+	LearnMoreLink  types.String                                           `tfsdk:"learn_more_link"`
+	Locations      fwtypes.ListNestedObjectValueOf[locationModel]         `tfsdk:"locations"`
+}
+
+type locationModel struct {
+	Path types.String                               `tfsdk:"path"`
+	Span fwtypes.ListNestedObjectValueOf[spanModel] `tfsdk:"span"`
+}
+
+type spanModel struct {
+	End   fwtypes.ListNestedObjectValueOf[positionModel] `tfsdk:"end"`
+	Start fwtypes.ListNestedObjectValueOf[positionModel] `tfsdk:"start"`
+}
+
+type positionModel struct {
+	Column types.Int32 `tfsdk:"column"`
+	Line   types.Int32 `tfsdk:"line"`
+	Offset types.Int32 `tfsdk:"offset"`
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfaccessanalyzer "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestFlattenPathElements(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    []awstypes.PathElement
+		expected string
+	}{
+		"empty": {
+			expected: "",
+		},
+		"key": {
+			input: []awstypes.PathElement{
+				&awstypes.PathElementMemberKey{Value: "Statement"},
+			},
+			expected: "Statement",
+		},
+		"statement action": {
+			input: []awstypes.PathElement{
+				&awstypes.PathElementMemberKey{Value: "Statement"},
+				&awstypes.PathElementMemberIndex{Value: 0},
+				&awstypes.PathElementMemberKey{Value: "Action"},
+				&awstypes.PathElementMemberIndex{Value: 1},
+			},
+			expected: "Statement[0].Action[1]",
+		},
+		"condition value": {
+			input: []awstypes.PathElement{
+				&awstypes.PathElementMemberKey{Value: "Condition"},
+				&awstypes.PathElementMemberValue{Value: "StringEquals"},
+			},
+			expected: `Condition["StringEquals"]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfaccessanalyzer.FlattenPathElements(testCase.input), testCase.expected; got != want {
+				t.Errorf("FlattenPathElements() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestValidatePolicyFindingTypeAtLeast(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		findingType awstypes.ValidatePolicyFindingType
+		threshold   awstypes.ValidatePolicyFindingType
+		expected    bool
+	}{
+		{awstypes.ValidatePolicyFindingTypeError, awstypes.ValidatePolicyFindingTypeError, true},
+		{awstypes.ValidatePolicyFindingTypeError, awstypes.ValidatePolicyFindingTypeSuggestion, true},
+		{awstypes.ValidatePolicyFindingTypeSecurityWarning, awstypes.ValidatePolicyFindingTypeError, false},
+		{awstypes.ValidatePolicyFindingTypeSecurityWarning, awstypes.ValidatePolicyFindingTypeWarning, true},
+		{awstypes.ValidatePolicyFindingTypeSuggestion, awstypes.ValidatePolicyFindingTypeWarning, false},
+		{awstypes.ValidatePolicyFindingType("UNKNOWN"), awstypes.ValidatePolicyFindingTypeSuggestion, false},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := tfaccessanalyzer.ValidatePolicyFindingTypeAtLeast(testCase.findingType, testCase.threshold), testCase.expected; got != want {
+			t.Errorf("ValidatePolicyFindingTypeAtLeast(%s, %s) = %t, want %t", testCase.findingType, testCase.threshold, got, want)
+		}
+	}
+}
+
+func TestAccAccessAnalyzerPolicyValidationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyValidationDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "findings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessAnalyzerPolicyValidationDataSource_findings(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyValidationDataSourceConfig_passRole,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "findings.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.finding_type", string(awstypes.ValidatePolicyFindingTypeSecurityWarning)),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.issue_code", "PASS_ROLE_WITH_STAR_IN_RESOURCE"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "findings.0.learn_more_link"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.locations.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.locations.0.path", "Statement[0].Resource"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.locations.0.span.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessAnalyzerPolicyValidationDataSource_failOnFindingType(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyValidationDataSourceConfig_failOnFindingType(string(awstypes.ValidatePolicyFindingTypeError)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "findings.#", "1"),
+				),
+			},
+			{
+				Config:      testAccPolicyValidationDataSourceConfig_failOnFindingType(string(awstypes.ValidatePolicyFindingTypeSecurityWarning)),
+				ExpectError: regexache.MustCompile(`IAM Access Analyzer policy validation returned 1 finding\(s\) of type\s+SECURITY_WARNING or more severe`),
+			},
+		},
+	})
+}
+
+const testAccPolicyValidationDataSourceConfig_basic = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["arn:${data.aws_partition.current.partition}:s3:::example-bucket/*"]
+  }
+}
+
+data "aws_partition" "current" {}
+
+data "aws_accessanalyzer_policy_validation" "test" {
+  policy_document = data.aws_iam_policy_document.test.json
+  policy_type     = "IDENTITY_POLICY"
+}
+`
+
+const testAccPolicyValidationDataSourceConfig_passRole = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["iam:PassRole"]
+    resources = ["*"]
+  }
+}
+
+data "aws_accessanalyzer_policy_validation" "test" {
+  policy_document = data.aws_iam_policy_document.test.json
+  policy_type     = "IDENTITY_POLICY"
+}
+`
+
+func testAccPolicyValidationDataSourceConfig_failOnFindingType(failOnFindingType string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["iam:PassRole"]
+    resources = ["*"]
+  }
+}
+
+data "aws_accessanalyzer_policy_validation" "test" {
+  policy_document      = data.aws_iam_policy_document.test.json
+  policy_type          = "IDENTITY_POLICY"
+  fail_on_finding_type = %[1]q
+}
+`, failOnFindingType)
+}
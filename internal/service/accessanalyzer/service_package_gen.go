@@ -15,7 +15,32 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newCheckAccessNotGrantedDataSource,
+			TypeName: "aws_accessanalyzer_check_access_not_granted",
+			Name:     "Check Access Not Granted",
+			Region:   types.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newCheckNoNewAccessDataSource,
+			TypeName: "aws_accessanalyzer_check_no_new_access",
+			Name:     "Check No New Access",
+			Region:   types.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newCheckNoPublicAccessDataSource,
+			TypeName: "aws_accessanalyzer_check_no_public_access",
+			Name:     "Check No Public Access",
+			Region:   types.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newPolicyValidationDataSource,
+			TypeName: "aws_accessanalyzer_policy_validation",
+			Name:     "Policy Validation",
+			Region:   types.ResourceRegionDefault(),
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -32,6 +57,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceAnalyzer,
 			TypeName: "aws_accessanalyzer_analyzer",
 			Name:     "Analyzer",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -40,6 +66,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceArchiveRule,
 			TypeName: "aws_accessanalyzer_archive_rule",
 			Name:     "Archive Rule",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
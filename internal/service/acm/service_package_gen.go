@@ -28,6 +28,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceCertificate,
 			TypeName: "aws_acm_certificate",
 			Name:     "Certificate",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -41,6 +42,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceCertificate,
 			TypeName: "aws_acm_certificate",
 			Name:     "Certificate",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -49,6 +51,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceCertificateValidation,
 			TypeName: "aws_acm_certificate_validation",
 			Name:     "Certificate Validation",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -28,11 +28,13 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceCertificate,
 			TypeName: "aws_acmpca_certificate",
 			Name:     "Certificate",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceCertificateAuthority,
 			TypeName: "aws_acmpca_certificate_authority",
 			Name:     "Certificate Authority",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -46,11 +48,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceCertificate,
 			TypeName: "aws_acmpca_certificate",
 			Name:     "Certificate",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceCertificateAuthority,
 			TypeName: "aws_acmpca_certificate_authority",
 			Name:     "Certificate Authority",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
@@ -59,16 +63,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceCertificateAuthorityCertificate,
 			TypeName: "aws_acmpca_certificate_authority_certificate",
 			Name:     "Certificate Authority Certificate",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourcePermission,
 			TypeName: "aws_acmpca_permission",
 			Name:     "Permission",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourcePolicy,
 			TypeName: "aws_acmpca_policy",
 			Name:     "Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
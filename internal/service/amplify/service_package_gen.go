@@ -32,6 +32,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceApp,
 			TypeName: "aws_amplify_app",
 			Name:     "App",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -40,11 +41,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceBackendEnvironment,
 			TypeName: "aws_amplify_backend_environment",
 			Name:     "Backend Environment",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBranch,
 			TypeName: "aws_amplify_branch",
 			Name:     "Branch",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -53,11 +56,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceDomainAssociation,
 			TypeName: "aws_amplify_domain_association",
 			Name:     "Domain Association",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceWebhook,
 			TypeName: "aws_amplify_webhook",
 			Name:     "Webhook",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -32,16 +32,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourcePolicy,
 			TypeName: "aws_appautoscaling_policy",
 			Name:     "Scaling Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceScheduledAction,
 			TypeName: "aws_appautoscaling_scheduled_action",
 			Name:     "Scheduled Action",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceTarget,
 			TypeName: "aws_appautoscaling_target",
 			Name:     "Target",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -32,11 +32,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceConnectorProfile,
 			TypeName: "aws_appflow_connector_profile",
 			Name:     "Connector Profile",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceFlow,
 			TypeName: "aws_appflow_flow",
 			Name:     "Flow",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -20,11 +20,13 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceControl,
 			TypeName: "aws_auditmanager_control",
 			Name:     "Control",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newDataSourceFramework,
 			TypeName: "aws_auditmanager_framework",
 			Name:     "Framework",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -35,11 +37,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceAccountRegistration,
 			TypeName: "aws_auditmanager_account_registration",
 			Name:     "Account Registration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceAssessment,
 			TypeName: "aws_auditmanager_assessment",
 			Name:     "Assessment",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -48,16 +52,19 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceAssessmentDelegation,
 			TypeName: "aws_auditmanager_assessment_delegation",
 			Name:     "Assessment Delegation",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceAssessmentReport,
 			TypeName: "aws_auditmanager_assessment_report",
 			Name:     "Assessment Report",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceControl,
 			TypeName: "aws_auditmanager_control",
 			Name:     "Control",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -66,6 +73,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceFramework,
 			TypeName: "aws_auditmanager_framework",
 			Name:     "Framework",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -74,11 +82,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceFrameworkShare,
 			TypeName: "aws_auditmanager_framework_share",
 			Name:     "Framework Share",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceOrganizationAdminAccountRegistration,
 			TypeName: "aws_auditmanager_organization_admin_account_registration",
 			Name:     "Organization Admin Account Registration",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
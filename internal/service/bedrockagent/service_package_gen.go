@@ -20,6 +20,7 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceAgentVersions,
 			TypeName: "aws_bedrockagent_agent_versions",
 			Name:     "Agent Versions",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -30,6 +31,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newAgentResource,
 			TypeName: "aws_bedrockagent_agent",
 			Name:     "Agent",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "agent_arn",
 			},
@@ -38,11 +40,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newAgentActionGroupResource,
 			TypeName: "aws_bedrockagent_agent_action_group",
 			Name:     "Agent Action Group",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newAgentAliasResource,
 			TypeName: "aws_bedrockagent_agent_alias",
 			Name:     "Agent Alias",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "agent_alias_arn",
 			},
@@ -51,21 +55,25 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newAgentCollaboratorResource,
 			TypeName: "aws_bedrockagent_agent_collaborator",
 			Name:     "Agent Collaborator",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newAgentKnowledgeBaseAssociationResource,
 			TypeName: "aws_bedrockagent_agent_knowledge_base_association",
 			Name:     "Agent Knowledge Base Association",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newDataSourceResource,
 			TypeName: "aws_bedrockagent_data_source",
 			Name:     "Data Source",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newKnowledgeBaseResource,
 			TypeName: "aws_bedrockagent_knowledge_base",
 			Name:     "Knowledge Base",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -20,6 +20,7 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceSlackWorkspace,
 			TypeName: "aws_chatbot_slack_workspace",
 			Name:     "Slack Workspace",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -30,6 +31,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newSlackChannelConfigurationResource,
 			TypeName: "aws_chatbot_slack_channel_configuration",
 			Name:     "Slack Channel Configuration",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "chat_configuration_arn",
 			},
@@ -38,6 +40,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newTeamsChannelConfigurationResource,
 			TypeName: "aws_chatbot_teams_channel_configuration",
 			Name:     "Teams Channel Configuration",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "chat_configuration_arn",
 			},
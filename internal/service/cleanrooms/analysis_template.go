@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameAnalysisTemplate = "Analysis Template"
+
+	analysisTemplateResourceIDPartCount = 2
+)
+
+// @FrameworkResource("aws_cleanrooms_analysis_template", name="Analysis Template")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newResourceAnalysisTemplate(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceAnalysisTemplate{}
+
+	return r, nil
+}
+
+type resourceAnalysisTemplate struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceAnalysisTemplate) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"analysis_template_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"collaboration_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrFormat: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AnalysisFormat](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"membership_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"analysis_parameters": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[analysisParameterData](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrDefaultValue: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ParameterType](),
+							Required:   true,
+						},
+					},
+				},
+			},
+			names.AttrSource: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[analysisSourceData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"text": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceAnalysisTemplate) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceAnalysisTemplateData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := cleanrooms.CreateAnalysisTemplateInput{
+		MembershipIdentifier: data.MembershipID.ValueStringPointer(),
+		Tags:                 getTagsIn(ctx),
+	}
+
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateAnalysisTemplate(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionCreating, ResNameAnalysisTemplate, data.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	template := output.AnalysisTemplate
+	id, err := intflex.FlattenResourceId([]string{aws.ToString(template.MembershipId), aws.ToString(template.Id)}, analysisTemplateResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionCreating, ResNameAnalysisTemplate, data.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.AnalysisTemplateID = fwflex.StringToFramework(ctx, template.Id)
+	data.ARN = fwflex.StringToFramework(ctx, template.Arn)
+	data.CollaborationID = fwflex.StringToFramework(ctx, template.CollaborationId)
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAnalysisTemplate) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceAnalysisTemplateData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := intflex.ExpandResourceId(data.ID.ValueString(), analysisTemplateResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionReading, ResNameAnalysisTemplate, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	output, err := findAnalysisTemplateByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionReading, ResNameAnalysisTemplate, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithIgnoredFieldNamesAppend("Id"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.AnalysisTemplateID = fwflex.StringToFramework(ctx, output.Id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAnalysisTemplate) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state resourceAnalysisTemplateData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Description.Equal(state.Description) {
+		input := cleanrooms.UpdateAnalysisTemplateInput{
+			AnalysisTemplateIdentifier: state.AnalysisTemplateID.ValueStringPointer(),
+			Description:                plan.Description.ValueStringPointer(),
+			MembershipIdentifier:       state.MembershipID.ValueStringPointer(),
+		}
+
+		_, err := conn.UpdateAnalysisTemplate(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.CleanRooms, create.ErrActionUpdating, ResNameAnalysisTemplate, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceAnalysisTemplate) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceAnalysisTemplateData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting CleanRooms Analysis Template", map[string]any{
+		names.AttrID: data.ID.ValueString(),
+	})
+
+	input := cleanrooms.DeleteAnalysisTemplateInput{
+		AnalysisTemplateIdentifier: data.AnalysisTemplateID.ValueStringPointer(),
+		MembershipIdentifier:       data.MembershipID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteAnalysisTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionDeleting, ResNameAnalysisTemplate, data.ID.String(), err),
+			err.Error(),
+		)
+	}
+}
+
+type resourceAnalysisTemplateData struct {
+	AnalysisParameters fwtypes.ListNestedObjectValueOf[analysisParameterData] `tfsdk:"analysis_parameters"`
+	AnalysisTemplateID types.String                                           `tfsdk:"analysis_template_id"`
+	ARN                types.String                                           `tfsdk:"arn"`
+	CollaborationID    types.String                                           `tfsdk:"collaboration_id"`
+	Description        types.String                                           `tfsdk:"description"`
+	Format             fwtypes.StringEnum[awstypes.AnalysisFormat]            `tfsdk:"format"`
+	ID                 types.String                                           `tfsdk:"id"`
+	MembershipID       types.String                                           `tfsdk:"membership_id"`
+	Name               types.String                                           `tfsdk:"name"`
+	Source             fwtypes.ListNestedObjectValueOf[analysisSourceData]    `tfsdk:"source"`
+	Tags               tftags.Map                                             `tfsdk:"tags"`
+	TagsAll            tftags.Map                                             `tfsdk:"tags_all"`
+}
+
+type analysisParameterData struct {
+	DefaultValue types.String                               `tfsdk:"default_value"`
+	Name         types.String                               `tfsdk:"name"`
+	Type         fwtypes.StringEnum[awstypes.ParameterType] `tfsdk:"type"`
+}
+
+var (
+	_ fwflex.Expander  = analysisSourceData{}
+	_ fwflex.Flattener = (*analysisSourceData)(nil)
+)
+
+type analysisSourceData struct {
+	Text types.String `tfsdk:"text"`
+}
+
+func (m analysisSourceData) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Text.IsNull():
+		var r awstypes.AnalysisSourceMemberText
+		r.Value = m.Text.ValueString()
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *analysisSourceData) Flatten(ctx context.Context, input any) (diags diag.Diagnostics) {
+	switch t := input.(type) {
+	case awstypes.AnalysisSourceMemberText:
+		m.Text = types.StringValue(t.Value)
+
+		return diags
+	}
+
+	return diags
+}
+
+func findAnalysisTemplateByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, analysisTemplateID string) (*awstypes.AnalysisTemplate, error) {
+	in := &cleanrooms.GetAnalysisTemplateInput{
+		AnalysisTemplateIdentifier: aws.String(analysisTemplateID),
+		MembershipIdentifier:       aws.String(membershipID),
+	}
+
+	out, err := conn.GetAnalysisTemplate(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AnalysisTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.AnalysisTemplate, nil
+}
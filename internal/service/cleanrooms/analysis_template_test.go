@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsAnalysisTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var template awstypes.AnalysisTemplate
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_analysis_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalysisTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalysisTemplateConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "analysis_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_parameters.0.name", "value"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_parameters.0.type", "VARCHAR"),
+					resource.TestCheckResourceAttrSet(resourceName, "analysis_template_id"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "collaboration_id", "aws_cleanrooms_collaboration.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrFormat, "SQL"),
+					resource.TestCheckResourceAttrPair(resourceName, "membership_id", "aws_cleanrooms_membership.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAnalysisTemplateConfig_basic(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsAnalysisTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var template awstypes.AnalysisTemplate
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_analysis_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalysisTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalysisTemplateConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisTemplateExists(ctx, resourceName, &template),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceAnalysisTemplate, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAnalysisTemplateExists(ctx context.Context, name string, v *awstypes.AnalysisTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameAnalysisTemplate, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		output, err := tfcleanrooms.FindAnalysisTemplateByTwoPartKey(ctx, conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["analysis_template_id"])
+
+		if err != nil {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameAnalysisTemplate, rs.Primary.ID, err)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAnalysisTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_analysis_template" {
+				continue
+			}
+
+			_, err := tfcleanrooms.FindAnalysisTemplateByTwoPartKey(ctx, conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["analysis_template_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.CleanRooms, create.ErrActionCheckingDestroyed, tfcleanrooms.ResNameAnalysisTemplate, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccAnalysisTemplateConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableAssociationConfig_basic(rName, rName), fmt.Sprintf(`
+resource "aws_cleanrooms_analysis_template" "test" {
+  name          = %[1]q
+  description   = %[2]q
+  membership_id = aws_cleanrooms_membership.test.id
+  format        = "SQL"
+
+  source {
+    text = "SELECT my_column_1 FROM \"${aws_cleanrooms_configured_table_association.test.name}\" WHERE my_column_2 = :value"
+  }
+
+  analysis_parameters {
+    name = "value"
+    type = "VARCHAR"
+  }
+}
+`, rName, description))
+}
@@ -0,0 +1,648 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameConfiguredTableAnalysisRule = "Configured Table Analysis Rule"
+
+	configuredTableAnalysisRuleResourceIDPartCount = 2
+)
+
+// @FrameworkResource("aws_cleanrooms_configured_table_analysis_rule", name="Configured Table Analysis Rule")
+func newResourceConfiguredTableAnalysisRule(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceConfiguredTableAnalysisRule{}
+
+	return r, nil
+}
+
+type resourceConfiguredTableAnalysisRule struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceConfiguredTableAnalysisRule) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	additionalAnalysesAttribute := schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[awstypes.AdditionalAnalyses](),
+		Optional:   true,
+		Computed:   true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	allowedJoinOperatorsAttribute := schema.SetAttribute{
+		CustomType: fwtypes.SetOfStringEnumType[awstypes.JoinOperator](),
+		Optional:   true,
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"analysis_rule_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ConfiguredTableAnalysisRuleType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"configured_table_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"analysis_rule_policy": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[configuredTableAnalysisRulePolicyData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"v1": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[configuredTableAnalysisRulePolicyV1Data](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"aggregation": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[analysisRuleAggregationData](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+											listvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("aggregation"),
+												path.MatchRelative().AtParent().AtName("custom"),
+												path.MatchRelative().AtParent().AtName("list"),
+											),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"additional_analyses":    additionalAnalysesAttribute,
+												"allowed_join_operators": allowedJoinOperatorsAttribute,
+												"dimension_columns": schema.SetAttribute{
+													CustomType: fwtypes.SetOfStringType,
+													Required:   true,
+												},
+												"join_columns": schema.SetAttribute{
+													CustomType: fwtypes.SetOfStringType,
+													Required:   true,
+												},
+												"join_required": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.JoinRequiredOption](),
+													Optional:   true,
+												},
+												"scalar_functions": schema.SetAttribute{
+													CustomType: fwtypes.SetOfStringEnumType[awstypes.ScalarFunctions](),
+													Required:   true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"aggregate_columns": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[aggregateColumnData](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtLeast(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"column_names": schema.SetAttribute{
+																CustomType: fwtypes.SetOfStringType,
+																Required:   true,
+															},
+															"function": schema.StringAttribute{
+																CustomType: fwtypes.StringEnumType[awstypes.AggregateFunctionName](),
+																Required:   true,
+															},
+														},
+													},
+												},
+												"output_constraints": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[aggregationConstraintData](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtLeast(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"column_name": schema.StringAttribute{
+																Required: true,
+															},
+															"minimum": schema.Int32Attribute{
+																Required: true,
+															},
+															names.AttrType: schema.StringAttribute{
+																CustomType: fwtypes.StringEnumType[awstypes.AggregationType](),
+																Required:   true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"custom": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[analysisRuleCustomData](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"additional_analyses": additionalAnalysesAttribute,
+												"allowed_analyses": schema.SetAttribute{
+													CustomType: fwtypes.SetOfStringType,
+													Required:   true,
+												},
+												"allowed_analysis_providers": schema.SetAttribute{
+													CustomType: fwtypes.SetOfStringType,
+													Optional:   true,
+												},
+												"disallowed_output_columns": schema.SetAttribute{
+													CustomType: fwtypes.SetOfStringType,
+													Optional:   true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"differential_privacy": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[differentialPrivacyConfigurationData](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Blocks: map[string]schema.Block{
+															"columns": schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[differentialPrivacyColumnData](ctx),
+																Validators: []validator.List{
+																	listvalidator.IsRequired(),
+																	listvalidator.SizeAtLeast(1),
+																},
+																NestedObject: schema.NestedBlockObject{
+																	Attributes: map[string]schema.Attribute{
+																		names.AttrName: schema.StringAttribute{
+																			Required: true,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"list": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[analysisRuleListData](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"additional_analyses":    additionalAnalysesAttribute,
+												"allowed_join_operators": allowedJoinOperatorsAttribute,
+												"join_columns": schema.SetAttribute{
+													CustomType: fwtypes.SetOfStringType,
+													Required:   true,
+												},
+												"list_columns": schema.SetAttribute{
+													CustomType: fwtypes.SetOfStringType,
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceConfiguredTableAnalysisRule) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceConfiguredTableAnalysisRuleData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := cleanrooms.CreateConfiguredTableAnalysisRuleInput{
+		ConfiguredTableIdentifier: data.ConfiguredTableID.ValueStringPointer(),
+	}
+
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateConfiguredTableAnalysisRule(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAnalysisRule, data.ConfiguredTableID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	id, err := intflex.FlattenResourceId([]string{data.ConfiguredTableID.ValueString(), data.AnalysisRuleType.ValueString()}, configuredTableAnalysisRuleResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAnalysisRule, data.ConfiguredTableID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.AnalysisRule, &data, fwflex.WithFieldNamePrefix("AnalysisRule"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceConfiguredTableAnalysisRule) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceConfiguredTableAnalysisRuleData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := intflex.ExpandResourceId(data.ID.ValueString(), configuredTableAnalysisRuleResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionReading, ResNameConfiguredTableAnalysisRule, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	output, err := findConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, parts[0], awstypes.ConfiguredTableAnalysisRuleType(parts[1]))
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionReading, ResNameConfiguredTableAnalysisRule, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("AnalysisRule"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceConfiguredTableAnalysisRule) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state resourceConfiguredTableAnalysisRuleData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.AnalysisRulePolicy.Equal(state.AnalysisRulePolicy) {
+		input := cleanrooms.UpdateConfiguredTableAnalysisRuleInput{
+			ConfiguredTableIdentifier: plan.ConfiguredTableID.ValueStringPointer(),
+		}
+
+		response.Diagnostics.Append(fwflex.Expand(ctx, plan, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.UpdateConfiguredTableAnalysisRule(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTableAnalysisRule, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output.AnalysisRule, &plan, fwflex.WithFieldNamePrefix("AnalysisRule"))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceConfiguredTableAnalysisRule) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceConfiguredTableAnalysisRuleData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting CleanRooms Configured Table Analysis Rule", map[string]any{
+		names.AttrID: data.ID.ValueString(),
+	})
+
+	input := cleanrooms.DeleteConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          data.AnalysisRuleType.ValueEnum(),
+		ConfiguredTableIdentifier: data.ConfiguredTableID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteConfiguredTableAnalysisRule(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionDeleting, ResNameConfiguredTableAnalysisRule, data.ID.String(), err),
+			err.Error(),
+		)
+	}
+}
+
+type resourceConfiguredTableAnalysisRuleData struct {
+	AnalysisRulePolicy fwtypes.ListNestedObjectValueOf[configuredTableAnalysisRulePolicyData] `tfsdk:"analysis_rule_policy"`
+	AnalysisRuleType   fwtypes.StringEnum[awstypes.ConfiguredTableAnalysisRuleType]           `tfsdk:"analysis_rule_type"`
+	ConfiguredTableID  types.String                                                           `tfsdk:"configured_table_id"`
+	ID                 types.String                                                           `tfsdk:"id"`
+}
+
+var (
+	_ fwflex.Expander  = configuredTableAnalysisRulePolicyData{}
+	_ fwflex.Flattener = (*configuredTableAnalysisRulePolicyData)(nil)
+	_ fwflex.Expander  = configuredTableAnalysisRulePolicyV1Data{}
+	_ fwflex.Flattener = (*configuredTableAnalysisRulePolicyV1Data)(nil)
+)
+
+type configuredTableAnalysisRulePolicyData struct {
+	V1 fwtypes.ListNestedObjectValueOf[configuredTableAnalysisRulePolicyV1Data] `tfsdk:"v1"`
+}
+
+func (m configuredTableAnalysisRulePolicyData) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.V1.IsNull():
+		v1Data, d := m.V1.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		v, d := v1Data.Expand(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ConfiguredTableAnalysisRulePolicyMemberV1
+		if v, ok := v.(awstypes.ConfiguredTableAnalysisRulePolicyV1); ok {
+			r.Value = v
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *configuredTableAnalysisRulePolicyData) Flatten(ctx context.Context, input any) (diags diag.Diagnostics) {
+	switch t := input.(type) {
+	case awstypes.ConfiguredTableAnalysisRulePolicyMemberV1:
+		var model configuredTableAnalysisRulePolicyV1Data
+		diags.Append(model.Flatten(ctx, t.Value)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.V1 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	default:
+		diags.AddError("Interface Conversion Error", fmt.Sprintf("cannot flatten %T into %T", input, m))
+	}
+
+	return diags
+}
+
+type configuredTableAnalysisRulePolicyV1Data struct {
+	Aggregation fwtypes.ListNestedObjectValueOf[analysisRuleAggregationData] `tfsdk:"aggregation"`
+	Custom      fwtypes.ListNestedObjectValueOf[analysisRuleCustomData]      `tfsdk:"custom"`
+	List        fwtypes.ListNestedObjectValueOf[analysisRuleListData]        `tfsdk:"list"`
+}
+
+func (m configuredTableAnalysisRulePolicyV1Data) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Aggregation.IsNull():
+		aggregationData, d := m.Aggregation.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ConfiguredTableAnalysisRulePolicyV1MemberAggregation
+		diags.Append(fwflex.Expand(ctx, aggregationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Custom.IsNull():
+		customData, d := m.Custom.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ConfiguredTableAnalysisRulePolicyV1MemberCustom
+		diags.Append(fwflex.Expand(ctx, customData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.List.IsNull():
+		listData, d := m.List.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ConfiguredTableAnalysisRulePolicyV1MemberList
+		diags.Append(fwflex.Expand(ctx, listData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *configuredTableAnalysisRulePolicyV1Data) Flatten(ctx context.Context, input any) (diags diag.Diagnostics) {
+	m.Aggregation = fwtypes.NewListNestedObjectValueOfNull[analysisRuleAggregationData](ctx)
+	m.Custom = fwtypes.NewListNestedObjectValueOfNull[analysisRuleCustomData](ctx)
+	m.List = fwtypes.NewListNestedObjectValueOfNull[analysisRuleListData](ctx)
+
+	switch t := input.(type) {
+	case *awstypes.ConfiguredTableAnalysisRulePolicyV1MemberAggregation:
+		var model analysisRuleAggregationData
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Aggregation = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case *awstypes.ConfiguredTableAnalysisRulePolicyV1MemberCustom:
+		var model analysisRuleCustomData
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Custom = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case *awstypes.ConfiguredTableAnalysisRulePolicyV1MemberList:
+		var model analysisRuleListData
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.List = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	default:
+		diags.AddError("Interface Conversion Error", fmt.Sprintf("cannot flatten %T into %T", input, m))
+	}
+
+	return diags
+}
+
+type analysisRuleAggregationData struct {
+	AdditionalAnalyses   fwtypes.StringEnum[awstypes.AdditionalAnalyses]                  `tfsdk:"additional_analyses"`
+	AggregateColumns     fwtypes.ListNestedObjectValueOf[aggregateColumnData]             `tfsdk:"aggregate_columns"`
+	AllowedJoinOperators fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.JoinOperator]]    `tfsdk:"allowed_join_operators"`
+	DimensionColumns     fwtypes.SetValueOf[types.String]                                 `tfsdk:"dimension_columns"`
+	JoinColumns          fwtypes.SetValueOf[types.String]                                 `tfsdk:"join_columns"`
+	JoinRequired         fwtypes.StringEnum[awstypes.JoinRequiredOption]                  `tfsdk:"join_required"`
+	OutputConstraints    fwtypes.ListNestedObjectValueOf[aggregationConstraintData]       `tfsdk:"output_constraints"`
+	ScalarFunctions      fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.ScalarFunctions]] `tfsdk:"scalar_functions"`
+}
+
+type aggregateColumnData struct {
+	ColumnNames fwtypes.SetValueOf[types.String]                   `tfsdk:"column_names"`
+	Function    fwtypes.StringEnum[awstypes.AggregateFunctionName] `tfsdk:"function"`
+}
+
+type aggregationConstraintData struct {
+	ColumnName types.String                                 `tfsdk:"column_name"`
+	Minimum    types.Int32                                  `tfsdk:"minimum"`
+	Type       fwtypes.StringEnum[awstypes.AggregationType] `tfsdk:"type"`
+}
+
+type analysisRuleCustomData struct {
+	AdditionalAnalyses       fwtypes.StringEnum[awstypes.AdditionalAnalyses]                       `tfsdk:"additional_analyses"`
+	AllowedAnalyses          fwtypes.SetValueOf[types.String]                                      `tfsdk:"allowed_analyses"`
+	AllowedAnalysisProviders fwtypes.SetValueOf[types.String]                                      `tfsdk:"allowed_analysis_providers"`
+	DifferentialPrivacy      fwtypes.ListNestedObjectValueOf[differentialPrivacyConfigurationData] `tfsdk:"differential_privacy"`
+	DisallowedOutputColumns  fwtypes.SetValueOf[types.String]                                      `tfsdk:"disallowed_output_columns"`
+}
+
+type differentialPrivacyConfigurationData struct {
+	Columns fwtypes.ListNestedObjectValueOf[differentialPrivacyColumnData] `tfsdk:"columns"`
+}
+
+type differentialPrivacyColumnData struct {
+	Name types.String `tfsdk:"name"`
+}
+
+type analysisRuleListData struct {
+	AdditionalAnalyses   fwtypes.StringEnum[awstypes.AdditionalAnalyses]               `tfsdk:"additional_analyses"`
+	AllowedJoinOperators fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.JoinOperator]] `tfsdk:"allowed_join_operators"`
+	JoinColumns          fwtypes.SetValueOf[types.String]                              `tfsdk:"join_columns"`
+	ListColumns          fwtypes.SetValueOf[types.String]                              `tfsdk:"list_columns"`
+}
+
+func findConfiguredTableAnalysisRuleByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, configuredTableID string, analysisRuleType awstypes.ConfiguredTableAnalysisRuleType) (*awstypes.ConfiguredTableAnalysisRule, error) {
+	in := &cleanrooms.GetConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          analysisRuleType,
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	out, err := conn.GetConfiguredTableAnalysisRule(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AnalysisRule == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.AnalysisRule, nil
+}
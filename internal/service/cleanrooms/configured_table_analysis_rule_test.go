@@ -0,0 +1,328 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_list(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var rule awstypes.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName, `["my_column_2"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", "LIST"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.aggregation.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.custom.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.list.0.join_columns.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "analysis_rule_policy.0.v1.0.list.0.join_columns.*", "my_column_1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.list.0.list_columns.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "analysis_rule_policy.0.v1.0.list.0.list_columns.*", "my_column_2"),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_id", "aws_cleanrooms_configured_table.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName, `["my_column_1", "my_column_2"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.list.0.list_columns.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_aggregation(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var rule awstypes.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_aggregation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", "AGGREGATION"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.aggregation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.aggregation.0.aggregate_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.aggregation.0.aggregate_columns.0.function", "COUNT_DISTINCT"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.aggregation.0.output_constraints.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.aggregation.0.output_constraints.0.minimum", "100"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.aggregation.0.scalar_functions.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_custom(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var rule awstypes.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_custom(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.custom.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.custom.0.allowed_analyses.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "analysis_rule_policy.0.v1.0.custom.0.allowed_analyses.*", "ANY_QUERY"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.v1.0.custom.0.disallowed_output_columns.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var rule awstypes.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName, `["my_column_2"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &rule),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceConfiguredTableAnalysisRule, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfiguredTableAnalysisRuleExists(ctx context.Context, name string, v *awstypes.ConfiguredTableAnalysisRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTableAnalysisRule, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		output, err := tfcleanrooms.FindConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, rs.Primary.Attributes["configured_table_id"], awstypes.ConfiguredTableAnalysisRuleType(rs.Primary.Attributes["analysis_rule_type"]))
+
+		if err != nil {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTableAnalysisRule, rs.Primary.ID, err)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckConfiguredTableAnalysisRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_configured_table_analysis_rule" {
+				continue
+			}
+
+			_, err := tfcleanrooms.FindConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, rs.Primary.Attributes["configured_table_id"], awstypes.ConfiguredTableAnalysisRuleType(rs.Primary.Attributes["analysis_rule_type"]))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.CleanRooms, create.ErrActionCheckingDestroyed, tfcleanrooms.ResNameConfiguredTableAnalysisRule, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+
+  storage_descriptor {
+    location = "s3://${aws_s3_bucket.test.bucket}"
+
+    columns {
+      name = "my_column_1"
+      type = "string"
+    }
+
+    columns {
+      name = "my_column_2"
+      type = "string"
+    }
+  }
+}
+
+resource "aws_cleanrooms_configured_table" "test" {
+  name            = %[1]q
+  analysis_method = "DIRECT_QUERY"
+  allowed_columns = ["my_column_1", "my_column_2"]
+
+  table_reference {
+    database_name = aws_glue_catalog_database.test.name
+    table_name    = aws_glue_catalog_table.test.name
+  }
+}
+`, rName)
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_list(rName, listColumns string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableAnalysisRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type  = "LIST"
+
+  analysis_rule_policy {
+    v1 {
+      list {
+        join_columns = ["my_column_1"]
+        list_columns = %[1]s
+      }
+    }
+  }
+}
+`, listColumns))
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_aggregation(rName string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableAnalysisRuleConfig_base(rName), `
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type  = "AGGREGATION"
+
+  analysis_rule_policy {
+    v1 {
+      aggregation {
+        dimension_columns = ["my_column_2"]
+        join_columns      = ["my_column_1"]
+        join_required     = "QUERY_RUNNER"
+        scalar_functions  = ["LOWER", "UPPER"]
+
+        aggregate_columns {
+          column_names = ["my_column_1"]
+          function     = "COUNT_DISTINCT"
+        }
+
+        output_constraints {
+          column_name = "my_column_1"
+          minimum     = 100
+          type        = "COUNT_DISTINCT"
+        }
+      }
+    }
+  }
+}
+`)
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_custom(rName string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableAnalysisRuleConfig_base(rName), `
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type  = "CUSTOM"
+
+  analysis_rule_policy {
+    v1 {
+      custom {
+        allowed_analyses          = ["ANY_QUERY"]
+        disallowed_output_columns = ["my_column_2"]
+      }
+    }
+  }
+}
+`)
+}
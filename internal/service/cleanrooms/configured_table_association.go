@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameConfiguredTableAssociation = "Configured Table Association"
+
+	configuredTableAssociationResourceIDPartCount = 2
+)
+
+// @FrameworkResource("aws_cleanrooms_configured_table_association", name="Configured Table Association")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newResourceConfiguredTableAssociation(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceConfiguredTableAssociation{}
+
+	return r, nil
+}
+
+type resourceConfiguredTableAssociation struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceConfiguredTableAssociation) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"configured_table_association_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"configured_table_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"membership_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *resourceConfiguredTableAssociation) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceConfiguredTableAssociationData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := cleanrooms.CreateConfiguredTableAssociationInput{
+		ConfiguredTableIdentifier: data.ConfiguredTableID.ValueStringPointer(),
+		MembershipIdentifier:      data.MembershipID.ValueStringPointer(),
+		Tags:                      getTagsIn(ctx),
+	}
+
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateConfiguredTableAssociation(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAssociation, data.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	association := output.ConfiguredTableAssociation
+	id, err := intflex.FlattenResourceId([]string{aws.ToString(association.MembershipId), aws.ToString(association.Id)}, configuredTableAssociationResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAssociation, data.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, association.Arn)
+	data.ConfiguredTableAssociationID = fwflex.StringToFramework(ctx, association.Id)
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceConfiguredTableAssociation) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceConfiguredTableAssociationData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := intflex.ExpandResourceId(data.ID.ValueString(), configuredTableAssociationResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionReading, ResNameConfiguredTableAssociation, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	output, err := findConfiguredTableAssociationByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionReading, ResNameConfiguredTableAssociation, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithIgnoredFieldNamesAppend("Id"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ConfiguredTableAssociationID = fwflex.StringToFramework(ctx, output.Id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceConfiguredTableAssociation) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state resourceConfiguredTableAssociationData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Description.Equal(state.Description) || !plan.RoleARN.Equal(state.RoleARN) {
+		input := cleanrooms.UpdateConfiguredTableAssociationInput{
+			ConfiguredTableAssociationIdentifier: state.ConfiguredTableAssociationID.ValueStringPointer(),
+			MembershipIdentifier:                 state.MembershipID.ValueStringPointer(),
+		}
+
+		response.Diagnostics.Append(fwflex.Expand(ctx, plan, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateConfiguredTableAssociation(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTableAssociation, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceConfiguredTableAssociation) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceConfiguredTableAssociationData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting CleanRooms Configured Table Association", map[string]any{
+		names.AttrID: data.ID.ValueString(),
+	})
+
+	input := cleanrooms.DeleteConfiguredTableAssociationInput{
+		ConfiguredTableAssociationIdentifier: data.ConfiguredTableAssociationID.ValueStringPointer(),
+		MembershipIdentifier:                 data.MembershipID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteConfiguredTableAssociation(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionDeleting, ResNameConfiguredTableAssociation, data.ID.String(), err),
+			err.Error(),
+		)
+	}
+}
+
+type resourceConfiguredTableAssociationData struct {
+	ARN                          types.String `tfsdk:"arn"`
+	ConfiguredTableAssociationID types.String `tfsdk:"configured_table_association_id"`
+	ConfiguredTableID            types.String `tfsdk:"configured_table_id"`
+	Description                  types.String `tfsdk:"description"`
+	ID                           types.String `tfsdk:"id"`
+	MembershipID                 types.String `tfsdk:"membership_id"`
+	Name                         types.String `tfsdk:"name"`
+	RoleARN                      fwtypes.ARN  `tfsdk:"role_arn"`
+	Tags                         tftags.Map   `tfsdk:"tags"`
+	TagsAll                      tftags.Map   `tfsdk:"tags_all"`
+}
+
+func findConfiguredTableAssociationByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, configuredTableAssociationID string) (*awstypes.ConfiguredTableAssociation, error) {
+	in := &cleanrooms.GetConfiguredTableAssociationInput{
+		ConfiguredTableAssociationIdentifier: aws.String(configuredTableAssociationID),
+		MembershipIdentifier:                 aws.String(membershipID),
+	}
+
+	out, err := conn.GetConfiguredTableAssociation(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.ConfiguredTableAssociation == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.ConfiguredTableAssociation, nil
+}
@@ -5,7 +5,19 @@ package cleanrooms
 
 // Exports for use in tests only.
 var (
-	ResourceMembership = newResourceMembership
+	ResourceAnalysisTemplate            = newResourceAnalysisTemplate
+	ResourceConfiguredTableAnalysisRule = newResourceConfiguredTableAnalysisRule
+	ResourceConfiguredTableAssociation  = newResourceConfiguredTableAssociation
+	ResourceIDMappingTable              = newResourceIDMappingTable
+	ResourceIDNamespaceAssociation      = newResourceIDNamespaceAssociation
+	ResourceMembership                  = newResourceMembership
+	ResourcePrivacyBudgetTemplate       = newResourcePrivacyBudgetTemplate
 
-	FindMembershipByID = findMembershipByID
+	FindAnalysisTemplateByTwoPartKey            = findAnalysisTemplateByTwoPartKey
+	FindConfiguredTableAnalysisRuleByTwoPartKey = findConfiguredTableAnalysisRuleByTwoPartKey
+	FindConfiguredTableAssociationByTwoPartKey  = findConfiguredTableAssociationByTwoPartKey
+	FindIDMappingTableByTwoPartKey              = findIDMappingTableByTwoPartKey
+	FindIDNamespaceAssociationByTwoPartKey      = findIDNamespaceAssociationByTwoPartKey
+	FindMembershipByID                          = findMembershipByID
+	FindPrivacyBudgetTemplateByTwoPartKey       = findPrivacyBudgetTemplateByTwoPartKey
 )
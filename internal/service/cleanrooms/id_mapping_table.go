@@ -0,0 +1,319 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameIDMappingTable = "ID Mapping Table"
+
+	idMappingTableResourceIDPartCount = 2
+)
+
+// @FrameworkResource("aws_cleanrooms_id_mapping_table", name="ID Mapping Table")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newResourceIDMappingTable(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceIDMappingTable{}
+
+	return r, nil
+}
+
+type resourceIDMappingTable struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceIDMappingTable) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"collaboration_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"id_mapping_table_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrKMSKeyARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"membership_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"input_reference_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[inputReferenceConfigData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"input_reference_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						"manage_resource_policies": schema.BoolAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceIDMappingTable) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceIDMappingTableData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := cleanrooms.CreateIdMappingTableInput{
+		MembershipIdentifier: data.MembershipID.ValueStringPointer(),
+		Tags:                 getTagsIn(ctx),
+	}
+
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateIdMappingTable(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionCreating, ResNameIDMappingTable, data.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	table := output.IdMappingTable
+	id, err := intflex.FlattenResourceId([]string{aws.ToString(table.MembershipId), aws.ToString(table.Id)}, idMappingTableResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionCreating, ResNameIDMappingTable, data.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, table.Arn)
+	data.CollaborationID = fwflex.StringToFramework(ctx, table.CollaborationId)
+	data.ID = types.StringValue(id)
+	data.IDMappingTableID = fwflex.StringToFramework(ctx, table.Id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceIDMappingTable) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceIDMappingTableData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := intflex.ExpandResourceId(data.ID.ValueString(), idMappingTableResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionReading, ResNameIDMappingTable, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	output, err := findIDMappingTableByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionReading, ResNameIDMappingTable, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithIgnoredFieldNamesAppend("Id"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.IDMappingTableID = fwflex.StringToFramework(ctx, output.Id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceIDMappingTable) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state resourceIDMappingTableData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Description.Equal(state.Description) || !plan.KMSKeyARN.Equal(state.KMSKeyARN) {
+		input := cleanrooms.UpdateIdMappingTableInput{
+			IdMappingTableIdentifier: state.IDMappingTableID.ValueStringPointer(),
+			MembershipIdentifier:     state.MembershipID.ValueStringPointer(),
+		}
+
+		response.Diagnostics.Append(fwflex.Expand(ctx, plan, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateIdMappingTable(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.CleanRooms, create.ErrActionUpdating, ResNameIDMappingTable, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceIDMappingTable) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceIDMappingTableData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting CleanRooms ID Mapping Table", map[string]any{
+		names.AttrID: data.ID.ValueString(),
+	})
+
+	input := cleanrooms.DeleteIdMappingTableInput{
+		IdMappingTableIdentifier: data.IDMappingTableID.ValueStringPointer(),
+		MembershipIdentifier:     data.MembershipID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteIdMappingTable(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionDeleting, ResNameIDMappingTable, data.ID.String(), err),
+			err.Error(),
+		)
+	}
+}
+
+type resourceIDMappingTableData struct {
+	ARN                  types.String                                              `tfsdk:"arn"`
+	CollaborationID      types.String                                              `tfsdk:"collaboration_id"`
+	Description          types.String                                              `tfsdk:"description"`
+	ID                   types.String                                              `tfsdk:"id"`
+	IDMappingTableID     types.String                                              `tfsdk:"id_mapping_table_id"`
+	InputReferenceConfig fwtypes.ListNestedObjectValueOf[inputReferenceConfigData] `tfsdk:"input_reference_config"`
+	KMSKeyARN            fwtypes.ARN                                               `tfsdk:"kms_key_arn"`
+	MembershipID         types.String                                              `tfsdk:"membership_id"`
+	Name                 types.String                                              `tfsdk:"name"`
+	Tags                 tftags.Map                                                `tfsdk:"tags"`
+	TagsAll              tftags.Map                                                `tfsdk:"tags_all"`
+}
+
+func findIDMappingTableByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, idMappingTableID string) (*awstypes.IdMappingTable, error) {
+	in := &cleanrooms.GetIdMappingTableInput{
+		IdMappingTableIdentifier: aws.String(idMappingTableID),
+		MembershipIdentifier:     aws.String(membershipID),
+	}
+
+	out, err := conn.GetIdMappingTable(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.IdMappingTable == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.IdMappingTable, nil
+}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The AWS Entity Resolution ID mapping workflow used by these tests is not managed by this provider.
+// Its source and target ID namespaces must be associated with the collaboration via
+// aws_cleanrooms_id_namespace_association.
+const (
+	envVarIDMappingWorkflowARN = "CLEANROOMS_ID_MAPPING_WORKFLOW_ARN"
+	envVarSourceIDNamespaceARN = "CLEANROOMS_SOURCE_ID_NAMESPACE_ARN"
+	envVarTargetIDNamespaceARN = "CLEANROOMS_TARGET_ID_NAMESPACE_ARN"
+)
+
+func TestAccCleanRoomsIDMappingTable_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var table awstypes.IdMappingTable
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_id_mapping_table.test"
+	idMappingWorkflowARN := acctest.SkipIfEnvVarNotSet(t, envVarIDMappingWorkflowARN)
+	sourceIDNamespaceARN := acctest.SkipIfEnvVarNotSet(t, envVarSourceIDNamespaceARN)
+	targetIDNamespaceARN := acctest.SkipIfEnvVarNotSet(t, envVarTargetIDNamespaceARN)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDMappingTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDMappingTableConfig_basic(rName, idMappingWorkflowARN, sourceIDNamespaceARN, targetIDNamespaceARN, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDMappingTableExists(ctx, resourceName, &table),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "collaboration_id", "aws_cleanrooms_collaboration.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test"),
+					resource.TestCheckResourceAttrSet(resourceName, "id_mapping_table_id"),
+					resource.TestCheckResourceAttr(resourceName, "input_reference_config.0.input_reference_arn", idMappingWorkflowARN),
+					resource.TestCheckResourceAttrPair(resourceName, "membership_id", "aws_cleanrooms_membership.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIDMappingTableConfig_basic(rName, idMappingWorkflowARN, sourceIDNamespaceARN, targetIDNamespaceARN, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDMappingTableExists(ctx, resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsIDMappingTable_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var table awstypes.IdMappingTable
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_id_mapping_table.test"
+	idMappingWorkflowARN := acctest.SkipIfEnvVarNotSet(t, envVarIDMappingWorkflowARN)
+	sourceIDNamespaceARN := acctest.SkipIfEnvVarNotSet(t, envVarSourceIDNamespaceARN)
+	targetIDNamespaceARN := acctest.SkipIfEnvVarNotSet(t, envVarTargetIDNamespaceARN)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDMappingTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDMappingTableConfig_basic(rName, idMappingWorkflowARN, sourceIDNamespaceARN, targetIDNamespaceARN, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDMappingTableExists(ctx, resourceName, &table),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceIDMappingTable, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIDMappingTableExists(ctx context.Context, name string, v *awstypes.IdMappingTable) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameIDMappingTable, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		output, err := tfcleanrooms.FindIDMappingTableByTwoPartKey(ctx, conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["id_mapping_table_id"])
+
+		if err != nil {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameIDMappingTable, rs.Primary.ID, err)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckIDMappingTableDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_id_mapping_table" {
+				continue
+			}
+
+			_, err := tfcleanrooms.FindIDMappingTableByTwoPartKey(ctx, conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["id_mapping_table_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.CleanRooms, create.ErrActionCheckingDestroyed, tfcleanrooms.ResNameIDMappingTable, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccIDMappingTableConfig_basic(rName, idMappingWorkflowARN, sourceIDNamespaceARN, targetIDNamespaceARN, description string) string {
+	return acctest.ConfigCompose(testAccMembershipConfig_creator(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_id_namespace_association" "source" {
+  name          = "%[1]s-source"
+  membership_id = aws_cleanrooms_membership.test.id
+
+  input_reference_config {
+    input_reference_arn      = %[3]q
+    manage_resource_policies = true
+  }
+}
+
+resource "aws_cleanrooms_id_namespace_association" "target" {
+  name          = "%[1]s-target"
+  membership_id = aws_cleanrooms_membership.test.id
+
+  input_reference_config {
+    input_reference_arn      = %[4]q
+    manage_resource_policies = true
+  }
+}
+
+resource "aws_cleanrooms_id_mapping_table" "test" {
+  name          = %[1]q
+  description   = %[5]q
+  membership_id = aws_cleanrooms_membership.test.id
+
+  input_reference_config {
+    input_reference_arn      = %[2]q
+    manage_resource_policies = true
+  }
+
+  depends_on = [
+    aws_cleanrooms_id_namespace_association.source,
+    aws_cleanrooms_id_namespace_association.target,
+  ]
+}
+`, rName, idMappingWorkflowARN, sourceIDNamespaceARN, targetIDNamespaceARN, description))
+}
@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameIDNamespaceAssociation = "ID Namespace Association"
+
+	idNamespaceAssociationResourceIDPartCount = 2
+)
+
+// @FrameworkResource("aws_cleanrooms_id_namespace_association", name="ID Namespace Association")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newResourceIDNamespaceAssociation(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceIDNamespaceAssociation{}
+
+	return r, nil
+}
+
+type resourceIDNamespaceAssociation struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceIDNamespaceAssociation) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"collaboration_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"id_namespace_association_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"membership_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"id_mapping_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[idMappingConfigData](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"allow_use_as_dimension_column": schema.BoolAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"input_reference_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[inputReferenceConfigData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"input_reference_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						"manage_resource_policies": schema.BoolAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceIDNamespaceAssociation) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceIDNamespaceAssociationData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := cleanrooms.CreateIdNamespaceAssociationInput{
+		MembershipIdentifier: data.MembershipID.ValueStringPointer(),
+		Tags:                 getTagsIn(ctx),
+	}
+
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateIdNamespaceAssociation(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionCreating, ResNameIDNamespaceAssociation, data.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	association := output.IdNamespaceAssociation
+	id, err := intflex.FlattenResourceId([]string{aws.ToString(association.MembershipId), aws.ToString(association.Id)}, idNamespaceAssociationResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionCreating, ResNameIDNamespaceAssociation, data.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, association.Arn)
+	data.CollaborationID = fwflex.StringToFramework(ctx, association.CollaborationId)
+	data.ID = types.StringValue(id)
+	data.IDNamespaceAssociationID = fwflex.StringToFramework(ctx, association.Id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceIDNamespaceAssociation) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceIDNamespaceAssociationData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := intflex.ExpandResourceId(data.ID.ValueString(), idNamespaceAssociationResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionReading, ResNameIDNamespaceAssociation, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	output, err := findIDNamespaceAssociationByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionReading, ResNameIDNamespaceAssociation, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithIgnoredFieldNamesAppend("Id"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.IDNamespaceAssociationID = fwflex.StringToFramework(ctx, output.Id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceIDNamespaceAssociation) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state resourceIDNamespaceAssociationData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Description.Equal(state.Description) ||
+		!plan.IDMappingConfig.Equal(state.IDMappingConfig) ||
+		!plan.Name.Equal(state.Name) {
+		input := cleanrooms.UpdateIdNamespaceAssociationInput{
+			IdNamespaceAssociationIdentifier: state.IDNamespaceAssociationID.ValueStringPointer(),
+			MembershipIdentifier:             state.MembershipID.ValueStringPointer(),
+		}
+
+		response.Diagnostics.Append(fwflex.Expand(ctx, plan, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateIdNamespaceAssociation(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.CleanRooms, create.ErrActionUpdating, ResNameIDNamespaceAssociation, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceIDNamespaceAssociation) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceIDNamespaceAssociationData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting CleanRooms ID Namespace Association", map[string]any{
+		names.AttrID: data.ID.ValueString(),
+	})
+
+	input := cleanrooms.DeleteIdNamespaceAssociationInput{
+		IdNamespaceAssociationIdentifier: data.IDNamespaceAssociationID.ValueStringPointer(),
+		MembershipIdentifier:             data.MembershipID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteIdNamespaceAssociation(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionDeleting, ResNameIDNamespaceAssociation, data.ID.String(), err),
+			err.Error(),
+		)
+	}
+}
+
+type resourceIDNamespaceAssociationData struct {
+	ARN                      types.String                                              `tfsdk:"arn"`
+	CollaborationID          types.String                                              `tfsdk:"collaboration_id"`
+	Description              types.String                                              `tfsdk:"description"`
+	ID                       types.String                                              `tfsdk:"id"`
+	IDMappingConfig          fwtypes.ListNestedObjectValueOf[idMappingConfigData]      `tfsdk:"id_mapping_config"`
+	IDNamespaceAssociationID types.String                                              `tfsdk:"id_namespace_association_id"`
+	InputReferenceConfig     fwtypes.ListNestedObjectValueOf[inputReferenceConfigData] `tfsdk:"input_reference_config"`
+	MembershipID             types.String                                              `tfsdk:"membership_id"`
+	Name                     types.String                                              `tfsdk:"name"`
+	Tags                     tftags.Map                                                `tfsdk:"tags"`
+	TagsAll                  tftags.Map                                                `tfsdk:"tags_all"`
+}
+
+type idMappingConfigData struct {
+	AllowUseAsDimensionColumn types.Bool `tfsdk:"allow_use_as_dimension_column"`
+}
+
+type inputReferenceConfigData struct {
+	InputReferenceARN      fwtypes.ARN `tfsdk:"input_reference_arn"`
+	ManageResourcePolicies types.Bool  `tfsdk:"manage_resource_policies"`
+}
+
+func findIDNamespaceAssociationByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, idNamespaceAssociationID string) (*awstypes.IdNamespaceAssociation, error) {
+	in := &cleanrooms.GetIdNamespaceAssociationInput{
+		IdNamespaceAssociationIdentifier: aws.String(idNamespaceAssociationID),
+		MembershipIdentifier:             aws.String(membershipID),
+	}
+
+	out, err := conn.GetIdNamespaceAssociation(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.IdNamespaceAssociation == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.IdNamespaceAssociation, nil
+}
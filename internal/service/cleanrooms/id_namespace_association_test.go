@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The AWS Entity Resolution ID namespace used by these tests is not managed by this provider.
+const envVarIDNamespaceARN = "CLEANROOMS_ID_NAMESPACE_ARN"
+
+func TestAccCleanRoomsIDNamespaceAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var association awstypes.IdNamespaceAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_id_namespace_association.test"
+	idNamespaceARN := acctest.SkipIfEnvVarNotSet(t, envVarIDNamespaceARN)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDNamespaceAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDNamespaceAssociationConfig_basic(rName, idNamespaceARN, "test", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDNamespaceAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "collaboration_id", "aws_cleanrooms_collaboration.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_config.0.allow_use_as_dimension_column", acctest.CtFalse),
+					resource.TestCheckResourceAttrSet(resourceName, "id_namespace_association_id"),
+					resource.TestCheckResourceAttr(resourceName, "input_reference_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_reference_config.0.input_reference_arn", idNamespaceARN),
+					resource.TestCheckResourceAttr(resourceName, "input_reference_config.0.manage_resource_policies", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(resourceName, "membership_id", "aws_cleanrooms_membership.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIDNamespaceAssociationConfig_basic(rName, idNamespaceARN, "updated", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDNamespaceAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "id_mapping_config.0.allow_use_as_dimension_column", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsIDNamespaceAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var association awstypes.IdNamespaceAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_id_namespace_association.test"
+	idNamespaceARN := acctest.SkipIfEnvVarNotSet(t, envVarIDNamespaceARN)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDNamespaceAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDNamespaceAssociationConfig_basic(rName, idNamespaceARN, "test", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDNamespaceAssociationExists(ctx, resourceName, &association),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceIDNamespaceAssociation, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIDNamespaceAssociationExists(ctx context.Context, name string, v *awstypes.IdNamespaceAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameIDNamespaceAssociation, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		output, err := tfcleanrooms.FindIDNamespaceAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["id_namespace_association_id"])
+
+		if err != nil {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameIDNamespaceAssociation, rs.Primary.ID, err)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckIDNamespaceAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_id_namespace_association" {
+				continue
+			}
+
+			_, err := tfcleanrooms.FindIDNamespaceAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["id_namespace_association_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.CleanRooms, create.ErrActionCheckingDestroyed, tfcleanrooms.ResNameIDNamespaceAssociation, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccIDNamespaceAssociationConfig_basic(rName, idNamespaceARN, description string, allowUseAsDimensionColumn bool) string {
+	return acctest.ConfigCompose(testAccMembershipConfig_creator(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_id_namespace_association" "test" {
+  name          = %[1]q
+  description   = %[3]q
+  membership_id = aws_cleanrooms_membership.test.id
+
+  input_reference_config {
+    input_reference_arn      = %[2]q
+    manage_resource_policies = true
+  }
+
+  id_mapping_config {
+    allow_use_as_dimension_column = %[4]t
+  }
+}
+`, rName, idNamespaceARN, description, allowUseAsDimensionColumn))
+}
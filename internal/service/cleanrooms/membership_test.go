@@ -486,3 +486,22 @@ resource "aws_cleanrooms_membership" "test" {
 }
 	`, rName, creatorDisplayName, creatorMemberAbilities, memberAbilities, queryLogStatus, defaultResultConfiguration, tagValue)
 }
+
+// testAccMembershipConfig_creator creates a single-account collaboration and the creator's own membership,
+// for use by resources that are scoped to a membership.
+func testAccMembershipConfig_creator(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cleanrooms_collaboration" "test" {
+  name                     = %[1]q
+  description              = "test"
+  creator_display_name     = "creator"
+  creator_member_abilities = ["CAN_QUERY", "CAN_RECEIVE_RESULTS"]
+  query_log_status         = "DISABLED"
+}
+
+resource "aws_cleanrooms_membership" "test" {
+  collaboration_id = aws_cleanrooms_collaboration.test.id
+  query_log_status = "DISABLED"
+}
+`, rName)
+}
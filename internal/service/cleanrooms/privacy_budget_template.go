@@ -0,0 +1,393 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNamePrivacyBudgetTemplate = "Privacy Budget Template"
+
+	privacyBudgetTemplateResourceIDPartCount = 2
+)
+
+// @FrameworkResource("aws_cleanrooms_privacy_budget_template", name="Privacy Budget Template")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newResourcePrivacyBudgetTemplate(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourcePrivacyBudgetTemplate{}
+
+	return r, nil
+}
+
+type resourcePrivacyBudgetTemplate struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourcePrivacyBudgetTemplate) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"auto_refresh": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PrivacyBudgetTemplateAutoRefresh](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"collaboration_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"membership_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"privacy_budget_template_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"privacy_budget_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PrivacyBudgetType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrParameters: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[privacyBudgetTemplateParametersData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"differential_privacy": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[differentialPrivacyTemplateParametersData](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"epsilon": schema.Int32Attribute{
+										Required: true,
+									},
+									"users_noise_per_query": schema.Int32Attribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourcePrivacyBudgetTemplate) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourcePrivacyBudgetTemplateData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := cleanrooms.CreatePrivacyBudgetTemplateInput{
+		MembershipIdentifier: data.MembershipID.ValueStringPointer(),
+		Tags:                 getTagsIn(ctx),
+	}
+
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreatePrivacyBudgetTemplate(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionCreating, ResNamePrivacyBudgetTemplate, data.MembershipID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	template := output.PrivacyBudgetTemplate
+	id, err := intflex.FlattenResourceId([]string{aws.ToString(template.MembershipId), aws.ToString(template.Id)}, privacyBudgetTemplateResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionCreating, ResNamePrivacyBudgetTemplate, data.MembershipID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, template.Arn)
+	data.CollaborationID = fwflex.StringToFramework(ctx, template.CollaborationId)
+	data.ID = types.StringValue(id)
+	data.PrivacyBudgetTemplateID = fwflex.StringToFramework(ctx, template.Id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourcePrivacyBudgetTemplate) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourcePrivacyBudgetTemplateData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := intflex.ExpandResourceId(data.ID.ValueString(), privacyBudgetTemplateResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionReading, ResNamePrivacyBudgetTemplate, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	output, err := findPrivacyBudgetTemplateByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionReading, ResNamePrivacyBudgetTemplate, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithIgnoredFieldNamesAppend("Id"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.PrivacyBudgetTemplateID = fwflex.StringToFramework(ctx, output.Id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourcePrivacyBudgetTemplate) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state resourcePrivacyBudgetTemplateData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Parameters.Equal(state.Parameters) {
+		parametersData, d := plan.Parameters.ToPtr(ctx)
+		response.Diagnostics.Append(d...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		differentialPrivacyData, d := parametersData.DifferentialPrivacy.ToPtr(ctx)
+		response.Diagnostics.Append(d...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		var parameters awstypes.PrivacyBudgetTemplateUpdateParametersMemberDifferentialPrivacy
+		response.Diagnostics.Append(fwflex.Expand(ctx, differentialPrivacyData, &parameters.Value)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := cleanrooms.UpdatePrivacyBudgetTemplateInput{
+			MembershipIdentifier:            state.MembershipID.ValueStringPointer(),
+			Parameters:                      &parameters,
+			PrivacyBudgetTemplateIdentifier: state.PrivacyBudgetTemplateID.ValueStringPointer(),
+			PrivacyBudgetType:               plan.PrivacyBudgetType.ValueEnum(),
+		}
+
+		_, err := conn.UpdatePrivacyBudgetTemplate(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.CleanRooms, create.ErrActionUpdating, ResNamePrivacyBudgetTemplate, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourcePrivacyBudgetTemplate) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourcePrivacyBudgetTemplateData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting CleanRooms Privacy Budget Template", map[string]any{
+		names.AttrID: data.ID.ValueString(),
+	})
+
+	input := cleanrooms.DeletePrivacyBudgetTemplateInput{
+		MembershipIdentifier:            data.MembershipID.ValueStringPointer(),
+		PrivacyBudgetTemplateIdentifier: data.PrivacyBudgetTemplateID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeletePrivacyBudgetTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionDeleting, ResNamePrivacyBudgetTemplate, data.ID.String(), err),
+			err.Error(),
+		)
+	}
+}
+
+type resourcePrivacyBudgetTemplateData struct {
+	ARN                     types.String                                                         `tfsdk:"arn"`
+	AutoRefresh             fwtypes.StringEnum[awstypes.PrivacyBudgetTemplateAutoRefresh]        `tfsdk:"auto_refresh"`
+	CollaborationID         types.String                                                         `tfsdk:"collaboration_id"`
+	ID                      types.String                                                         `tfsdk:"id"`
+	MembershipID            types.String                                                         `tfsdk:"membership_id"`
+	Parameters              fwtypes.ListNestedObjectValueOf[privacyBudgetTemplateParametersData] `tfsdk:"parameters"`
+	PrivacyBudgetTemplateID types.String                                                         `tfsdk:"privacy_budget_template_id"`
+	PrivacyBudgetType       fwtypes.StringEnum[awstypes.PrivacyBudgetType]                       `tfsdk:"privacy_budget_type"`
+	Tags                    tftags.Map                                                           `tfsdk:"tags"`
+	TagsAll                 tftags.Map                                                           `tfsdk:"tags_all"`
+}
+
+var (
+	_ fwflex.Expander  = privacyBudgetTemplateParametersData{}
+	_ fwflex.Flattener = (*privacyBudgetTemplateParametersData)(nil)
+)
+
+type privacyBudgetTemplateParametersData struct {
+	DifferentialPrivacy fwtypes.ListNestedObjectValueOf[differentialPrivacyTemplateParametersData] `tfsdk:"differential_privacy"`
+}
+
+type differentialPrivacyTemplateParametersData struct {
+	Epsilon            types.Int32 `tfsdk:"epsilon"`
+	UsersNoisePerQuery types.Int32 `tfsdk:"users_noise_per_query"`
+}
+
+func (m privacyBudgetTemplateParametersData) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.DifferentialPrivacy.IsNull():
+		differentialPrivacyData, d := m.DifferentialPrivacy.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.PrivacyBudgetTemplateParametersInputMemberDifferentialPrivacy
+		diags.Append(fwflex.Expand(ctx, differentialPrivacyData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *privacyBudgetTemplateParametersData) Flatten(ctx context.Context, input any) (diags diag.Diagnostics) {
+	switch t := input.(type) {
+	case awstypes.PrivacyBudgetTemplateParametersOutputMemberDifferentialPrivacy:
+		var model differentialPrivacyTemplateParametersData
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.DifferentialPrivacy = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+func findPrivacyBudgetTemplateByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, privacyBudgetTemplateID string) (*awstypes.PrivacyBudgetTemplate, error) {
+	in := &cleanrooms.GetPrivacyBudgetTemplateInput{
+		MembershipIdentifier:            aws.String(membershipID),
+		PrivacyBudgetTemplateIdentifier: aws.String(privacyBudgetTemplateID),
+	}
+
+	out, err := conn.GetPrivacyBudgetTemplate(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.PrivacyBudgetTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.PrivacyBudgetTemplate, nil
+}
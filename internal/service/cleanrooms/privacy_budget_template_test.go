@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsPrivacyBudgetTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var template awstypes.PrivacyBudgetTemplate
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_privacy_budget_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPrivacyBudgetTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivacyBudgetTemplateConfig_basic(rName, 1, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPrivacyBudgetTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "auto_refresh", "CALENDAR_MONTH"),
+					resource.TestCheckResourceAttrPair(resourceName, "collaboration_id", "aws_cleanrooms_collaboration.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "membership_id", "aws_cleanrooms_membership.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.differential_privacy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.differential_privacy.0.epsilon", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.differential_privacy.0.users_noise_per_query", "10"),
+					resource.TestCheckResourceAttrSet(resourceName, "privacy_budget_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "privacy_budget_type", "DIFFERENTIAL_PRIVACY"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPrivacyBudgetTemplateConfig_basic(rName, 2, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPrivacyBudgetTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.differential_privacy.0.epsilon", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.differential_privacy.0.users_noise_per_query", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsPrivacyBudgetTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var template awstypes.PrivacyBudgetTemplate
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_privacy_budget_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPrivacyBudgetTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivacyBudgetTemplateConfig_basic(rName, 1, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPrivacyBudgetTemplateExists(ctx, resourceName, &template),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourcePrivacyBudgetTemplate, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPrivacyBudgetTemplateExists(ctx context.Context, name string, v *awstypes.PrivacyBudgetTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNamePrivacyBudgetTemplate, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		output, err := tfcleanrooms.FindPrivacyBudgetTemplateByTwoPartKey(ctx, conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["privacy_budget_template_id"])
+
+		if err != nil {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNamePrivacyBudgetTemplate, rs.Primary.ID, err)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPrivacyBudgetTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_privacy_budget_template" {
+				continue
+			}
+
+			_, err := tfcleanrooms.FindPrivacyBudgetTemplateByTwoPartKey(ctx, conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["privacy_budget_template_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.CleanRooms, create.ErrActionCheckingDestroyed, tfcleanrooms.ResNamePrivacyBudgetTemplate, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccPrivacyBudgetTemplateConfig_basic(rName string, epsilon, usersNoisePerQuery int) string {
+	return acctest.ConfigCompose(testAccMembershipConfig_creator(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_privacy_budget_template" "test" {
+  membership_id       = aws_cleanrooms_membership.test.id
+  auto_refresh        = "CALENDAR_MONTH"
+  privacy_budget_type = "DIFFERENTIAL_PRIVACY"
+
+  parameters {
+    differential_privacy {
+      epsilon               = %[1]d
+      users_noise_per_query = %[2]d
+    }
+  }
+}
+`, epsilon, usersNoisePerQuery))
+}
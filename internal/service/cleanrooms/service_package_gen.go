@@ -20,10 +20,62 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory:  newResourceAnalysisTemplate,
+			TypeName: "aws_cleanrooms_analysis_template",
+			Name:     "Analysis Template",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newResourceConfiguredTableAnalysisRule,
+			TypeName: "aws_cleanrooms_configured_table_analysis_rule",
+			Name:     "Configured Table Analysis Rule",
+			Region:   types.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newResourceConfiguredTableAssociation,
+			TypeName: "aws_cleanrooms_configured_table_association",
+			Name:     "Configured Table Association",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newResourceIDMappingTable,
+			TypeName: "aws_cleanrooms_id_mapping_table",
+			Name:     "ID Mapping Table",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newResourceIDNamespaceAssociation,
+			TypeName: "aws_cleanrooms_id_namespace_association",
+			Name:     "ID Namespace Association",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  newResourceMembership,
 			TypeName: "aws_cleanrooms_membership",
 			Name:     "Membership",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newResourcePrivacyBudgetTemplate,
+			TypeName: "aws_cleanrooms_privacy_budget_template",
+			Name:     "Privacy Budget Template",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -41,6 +93,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceCollaboration,
 			TypeName: "aws_cleanrooms_collaboration",
 			Name:     "Collaboration",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -49,6 +102,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceConfiguredTable,
 			TypeName: "aws_cleanrooms_configured_table",
 			Name:     "Configured Table",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
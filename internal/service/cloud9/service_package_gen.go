@@ -32,6 +32,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceEnvironmentEC2,
 			TypeName: "aws_cloud9_environment_ec2",
 			Name:     "Environment EC2",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -40,6 +41,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceEnvironmentMembership,
 			TypeName: "aws_cloud9_environment_membership",
 			Name:     "Environment Membership",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -28,6 +28,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceResource,
 			TypeName: "aws_cloudcontrolapi_resource",
 			Name:     "Resource",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -38,6 +39,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceResource,
 			TypeName: "aws_cloudcontrolapi_resource",
 			Name:     "Resource",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
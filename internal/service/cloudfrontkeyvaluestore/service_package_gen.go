@@ -24,6 +24,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newKeyResource,
 			TypeName: "aws_cloudfrontkeyvaluestore_key",
 			Name:     "Key",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
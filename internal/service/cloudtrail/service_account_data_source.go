@@ -55,6 +55,7 @@ var serviceAccountPerRegionMap = map[string]string{
 }
 
 // @SDKDataSource("aws_cloudtrail_service_account", name="Service Account")
+// @Region(overrideEnabled=false)
 func dataSourceServiceAccount() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceServiceAccountRead,
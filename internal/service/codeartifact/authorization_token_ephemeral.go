@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameAuthorizationToken = "Ephemeral Resource Authorization Token"
+)
+
+// @EphemeralResource(aws_codeartifact_authorization_token, name="Authorization Token")
+func newEphemeralAuthorizationToken(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAuthorizationToken{}, nil
+}
+
+type ephemeralAuthorizationToken struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralAuthorizationToken) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authorization_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrDomain: schema.StringAttribute{
+				Required: true,
+			},
+			"domain_owner": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.Between(900, 43200),
+						int64validator.OneOf(0),
+					),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralAuthorizationToken) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().CodeArtifactClient(ctx)
+	data := epAuthorizationTokenData{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DomainOwner.IsNull() || data.DomainOwner.IsUnknown() {
+		data.DomainOwner = types.StringValue(e.Meta().AccountID(ctx))
+	}
+
+	input := codeartifact.GetAuthorizationTokenInput{
+		Domain:          fwflex.StringFromFramework(ctx, data.Domain),
+		DomainOwner:     fwflex.StringFromFramework(ctx, data.DomainOwner),
+		DurationSeconds: fwflex.Int64FromFramework(ctx, data.DurationSeconds),
+	}
+
+	output, err := conn.GetAuthorizationToken(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CodeArtifact, create.ErrActionOpening, ERNameAuthorizationToken, data.Domain.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.AuthorizationToken = fwflex.StringToFramework(ctx, output.AuthorizationToken)
+	data.Expiration = timetypes.NewRFC3339TimeValue(aws.ToTime(output.Expiration).UTC().Truncate(time.Second))
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epAuthorizationTokenData struct {
+	AuthorizationToken types.String      `tfsdk:"authorization_token"`
+	Domain             types.String      `tfsdk:"domain"`
+	DomainOwner        types.String      `tfsdk:"domain_owner"`
+	DurationSeconds    types.Int64       `tfsdk:"duration_seconds"`
+	Expiration         timetypes.RFC3339 `tfsdk:"expiration"`
+}
@@ -18,6 +18,9 @@ func TestAccCodeArtifact_serial(t *testing.T) {
 			"duration":      testAccAuthorizationTokenDataSource_duration,
 			"owner":         testAccAuthorizationTokenDataSource_owner,
 		},
+		"AuthorizationTokenEphemeral": {
+			acctest.CtBasic: testAccAuthorizationTokenEphemeral_basic,
+		},
 		"Domain": {
 			acctest.CtBasic:                 testAccDomain_basic,
 			"defaultEncryptionKey":          testAccDomain_defaultEncryptionKey,
@@ -28,6 +28,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceFleet,
 			TypeName: "aws_codebuild_fleet",
 			Name:     "Fleet",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -38,6 +39,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceFleet,
 			TypeName: "aws_codebuild_fleet",
 			Name:     "Fleet",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -46,28 +48,33 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceProject,
 			TypeName: "aws_codebuild_project",
 			Name:     "Project",
+			Region:   types.ResourceRegionDefault(),
 			Tags:     &types.ServicePackageResourceTags{},
 		},
 		{
 			Factory:  resourceReportGroup,
 			TypeName: "aws_codebuild_report_group",
 			Name:     "Report Group",
+			Region:   types.ResourceRegionDefault(),
 			Tags:     &types.ServicePackageResourceTags{},
 		},
 		{
 			Factory:  resourceResourcePolicy,
 			TypeName: "aws_codebuild_resource_policy",
 			Name:     "Resource Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceSourceCredential,
 			TypeName: "aws_codebuild_source_credential",
 			Name:     "Source Credential",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceWebhook,
 			TypeName: "aws_codebuild_webhook",
 			Name:     "Webhook",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
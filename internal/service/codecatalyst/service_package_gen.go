@@ -28,6 +28,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceDevEnvironment,
 			TypeName: "aws_codecatalyst_dev_environment",
 			Name:     "Dev Environment",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -38,16 +39,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceDevEnvironment,
 			TypeName: "aws_codecatalyst_dev_environment",
 			Name:     "DevEnvironment",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceProject,
 			TypeName: "aws_codecatalyst_project",
 			Name:     "Project",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceSourceRepository,
 			TypeName: "aws_codecatalyst_source_repository",
 			Name:     "Source Repository",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -28,11 +28,13 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceApprovalRuleTemplate,
 			TypeName: "aws_codecommit_approval_rule_template",
 			Name:     "Approval Rule Template",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceRepository,
 			TypeName: "aws_codecommit_repository",
 			Name:     "Repository",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -43,16 +45,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceApprovalRuleTemplate,
 			TypeName: "aws_codecommit_approval_rule_template",
 			Name:     "Approval Rule Template",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceApprovalRuleTemplateAssociation,
 			TypeName: "aws_codecommit_approval_rule_template_association",
 			Name:     "Approval Rule Template Association",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceRepository,
 			TypeName: "aws_codecommit_repository",
 			Name:     "Repository",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -61,6 +66,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceTrigger,
 			TypeName: "aws_codecommit_trigger",
 			Name:     "Trigger",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
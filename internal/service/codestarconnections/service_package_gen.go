@@ -28,6 +28,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceConnection,
 			TypeName: "aws_codestarconnections_connection",
 			Name:     "Connection",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -38,6 +39,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceConnection,
 			TypeName: "aws_codestarconnections_connection",
 			Name:     "Connection",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -46,6 +48,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceHost,
 			TypeName: "aws_codestarconnections_host",
 			Name:     "Host",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
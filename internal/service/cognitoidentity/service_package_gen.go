@@ -20,6 +20,7 @@ func (p *servicePackage) EphemeralResources(ctx context.Context) []*types.Servic
 			Factory:  newOpenIDTokenForDeveloperIdentityEphemeralResource,
 			TypeName: "aws_cognito_identity_openid_token_for_developer_identity",
 			Name:     "Open ID Connect Token For Developer Identity",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -38,6 +39,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourcePool,
 			TypeName: "aws_cognito_identity_pool",
 			Name:     "Pool",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -51,6 +53,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourcePool,
 			TypeName: "aws_cognito_identity_pool",
 			Name:     "Pool",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -59,11 +62,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourcePoolProviderPrincipalTag,
 			TypeName: "aws_cognito_identity_pool_provider_principal_tag",
 			Name:     "Provider Principal Tags",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourcePoolRolesAttachment,
 			TypeName: "aws_cognito_identity_pool_roles_attachment",
 			Name:     "Pool Roles Association",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
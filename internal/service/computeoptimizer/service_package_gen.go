@@ -24,11 +24,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newEnrollmentStatusResource,
 			TypeName: "aws_computeoptimizer_enrollment_status",
 			Name:     "Enrollment Status",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newRecommendationPreferencesResource,
 			TypeName: "aws_computeoptimizer_recommendation_preferences",
 			Name:     "Recommendation Preferences",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
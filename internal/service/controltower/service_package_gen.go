@@ -28,6 +28,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceControls,
 			TypeName: "aws_controltower_controls",
 			Name:     "Control",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -38,11 +39,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceControl,
 			TypeName: "aws_controltower_control",
 			Name:     "Control",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceLandingZone,
 			TypeName: "aws_controltower_landing_zone",
 			Name:     "Landing Zone",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
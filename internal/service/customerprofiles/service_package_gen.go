@@ -32,6 +32,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceDomain,
 			TypeName: "aws_customerprofiles_domain",
 			Name:     "Domain",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -40,6 +41,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceProfile,
 			TypeName: "aws_customerprofiles_profile",
 			Name:     "Profile",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -28,12 +28,14 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourcePipeline,
 			TypeName: "aws_datapipeline_pipeline",
 			Name:     "Pipeline",
+			Region:   types.ResourceRegionDefault(),
 			Tags:     &types.ServicePackageResourceTags{},
 		},
 		{
 			Factory:  DataSourcePipelineDefinition,
 			TypeName: "aws_datapipeline_pipeline_definition",
 			Name:     "Pipeline Definition",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -44,6 +46,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourcePipeline,
 			TypeName: "aws_datapipeline_pipeline",
 			Name:     "Pipeline",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "Pipeline",
@@ -53,6 +56,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourcePipelineDefinition,
 			TypeName: "aws_datapipeline_pipeline_definition",
 			Name:     "Pipeline Definition",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
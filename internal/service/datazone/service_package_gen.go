@@ -20,11 +20,13 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceDomain,
 			TypeName: "aws_datazone_domain",
 			Name:     "Domain",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newDataSourceEnvironmentBlueprint,
 			TypeName: "aws_datazone_environment_blueprint",
 			Name:     "Environment Blueprint",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -35,11 +37,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceAssetType,
 			TypeName: "aws_datazone_asset_type",
 			Name:     "Asset Type",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceDomain,
 			TypeName: "aws_datazone_domain",
 			Name:     "Domain",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -48,41 +52,49 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceEnvironment,
 			TypeName: "aws_datazone_environment",
 			Name:     "Environment",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceEnvironmentBlueprintConfiguration,
 			TypeName: "aws_datazone_environment_blueprint_configuration",
 			Name:     "Environment Blueprint Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceEnvironmentProfile,
 			TypeName: "aws_datazone_environment_profile",
 			Name:     "Environment Profile",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceFormType,
 			TypeName: "aws_datazone_form_type",
 			Name:     "Form Type",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceGlossary,
 			TypeName: "aws_datazone_glossary",
 			Name:     "Glossary",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceGlossaryTerm,
 			TypeName: "aws_datazone_glossary_term",
 			Name:     "Glossary Term",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceProject,
 			TypeName: "aws_datazone_project",
 			Name:     "Project",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceUserProfile,
 			TypeName: "aws_datazone_user_profile",
 			Name:     "User Profile",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -32,6 +32,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceCluster,
 			TypeName: "aws_dax_cluster",
 			Name:     "Cluster",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -40,11 +41,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceParameterGroup,
 			TypeName: "aws_dax_parameter_group",
 			Name:     "Parameter Group",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceSubnetGroup,
 			TypeName: "aws_dax_subnet_group",
 			Name:     "Subnet Group",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
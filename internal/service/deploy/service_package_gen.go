@@ -32,6 +32,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceApp,
 			TypeName: "aws_codedeploy_app",
 			Name:     "App",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -40,11 +41,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceDeploymentConfig,
 			TypeName: "aws_codedeploy_deployment_config",
 			Name:     "Deployment Config",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceDeploymentGroup,
 			TypeName: "aws_codedeploy_deployment_group",
 			Name:     "Deployment Group",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
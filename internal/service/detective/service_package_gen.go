@@ -32,6 +32,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceGraph,
 			TypeName: "aws_detective_graph",
 			Name:     "Graph",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "graph_arn",
 			},
@@ -40,21 +41,25 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceInvitationAccepter,
 			TypeName: "aws_detective_invitation_accepter",
 			Name:     "Invitation Accepter",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceMember,
 			TypeName: "aws_detective_member",
 			Name:     "Member",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceOrganizationAdminAccount,
 			TypeName: "aws_detective_organization_admin_account",
 			Name:     "Organization Admin Account",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceOrganizationConfiguration,
 			TypeName: "aws_detective_organization_configuration",
 			Name:     "Organization Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
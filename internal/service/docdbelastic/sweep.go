@@ -18,7 +18,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_docdbelastic_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdbelastic_cluster", &resource.Sweeper{
 		Name: "aws_docdbelastic_cluster",
 		F:    sweepClusters,
 	})
@@ -5,6 +5,13 @@ package drs
 
 // Exports for use in tests only.
 const (
-	ResNameReplicationConfigurationTemplate   = "Replication Configuration Template"
-	ResPrefixReplicationConfigurationTemplate = "ReplicationConfigurationTemplate"
+	ResNameLaunchConfigurationTemplate          = "Launch Configuration Template"
+	ResNameReplicationConfigurationTemplate     = "Replication Configuration Template"
+	ResPrefixReplicationConfigurationTemplate   = "ReplicationConfigurationTemplate"
+	ResNameServiceInitialization                = "Service Initialization"
+	ResNameSourceServerLaunchConfiguration      = "Source Server Launch Configuration"
+	ResNameSourceServerReplicationConfiguration = "Source Server Replication Configuration"
+
+	DSNameRecoveryInstances = "Recovery Instances Data Source"
+	DSNameSourceServers     = "Source Servers Data Source"
 )
@@ -5,7 +5,15 @@ package drs
 
 // Exports for use in tests only.
 var (
-	ResourceReplicationConfigurationTemplate = newReplicationConfigurationTemplateResource
+	ResourceLaunchConfigurationTemplate          = newLaunchConfigurationTemplateResource
+	ResourceReplicationConfigurationTemplate     = newReplicationConfigurationTemplateResource
+	ResourceServiceInitialization                = newServiceInitializationResource
+	ResourceSourceServerLaunchConfiguration      = newSourceServerLaunchConfigurationResource
+	ResourceSourceServerReplicationConfiguration = newSourceServerReplicationConfigurationResource
 
-	FindReplicationConfigurationTemplateByID = findReplicationConfigurationTemplateByID
+	FindLaunchConfigurationBySourceServerID      = findLaunchConfigurationBySourceServerID
+	FindLaunchConfigurationTemplateByID          = findLaunchConfigurationTemplateByID
+	FindReplicationConfigurationBySourceServerID = findReplicationConfigurationBySourceServerID
+	FindReplicationConfigurationTemplateByID     = findReplicationConfigurationTemplateByID
+	FindServiceInitialization                    = findServiceInitialization
 )
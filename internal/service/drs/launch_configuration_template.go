@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package drs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/drs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/drs/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_drs_launch_configuration_template", name="Launch Configuration Template")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/drs/types;awstypes;awstypes.LaunchConfigurationTemplate")
+// @Testing(serialize=true)
+func newLaunchConfigurationTemplateResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &launchConfigurationTemplateResource{}
+
+	return r, nil
+}
+
+type launchConfigurationTemplateResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *launchConfigurationTemplateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"copy_private_ip": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"copy_tags": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"export_bucket_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"launch_disposition": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LaunchDisposition](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"launch_into_source_instance": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"licensing": framework.ResourceOptionalComputedListOfObjectsAttribute[licensingModel](ctx, 1, nil, listplanmodifier.UseStateForUnknown()),
+			"post_launch_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"target_instance_type_right_sizing_method": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TargetInstanceTypeRightSizingMethod](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+var launchConfigurationTemplateFlexOpt = flex.WithFieldNamePrefix("LaunchConfigurationTemplate")
+
+func (r *launchConfigurationTemplateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data launchConfigurationTemplateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DRSClient(ctx)
+
+	var input drs.CreateLaunchConfigurationTemplateInput
+	response.Diagnostics.Append(flex.Expand(ctx, data, &input, launchConfigurationTemplateFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateLaunchConfigurationTemplate(ctx, &input)
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.DRS, create.ErrActionCreating, ResNameLaunchConfigurationTemplate, "", err)
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, output.LaunchConfigurationTemplate, &data, launchConfigurationTemplateFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *launchConfigurationTemplateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data launchConfigurationTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DRSClient(ctx)
+
+	output, err := findLaunchConfigurationTemplateByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.DRS, create.ErrActionReading, ResNameLaunchConfigurationTemplate, data.ID.ValueString(), err)
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, output, &data, launchConfigurationTemplateFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *launchConfigurationTemplateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new launchConfigurationTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DRSClient(ctx)
+
+	if launchConfigurationTemplateHasChanges(ctx, new, old) {
+		var input drs.UpdateLaunchConfigurationTemplateInput
+		response.Diagnostics.Append(flex.Expand(ctx, new, &input, launchConfigurationTemplateFlexOpt)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.UpdateLaunchConfigurationTemplate(ctx, &input)
+
+		if err != nil {
+			create.AddError(&response.Diagnostics, names.DRS, create.ErrActionUpdating, ResNameLaunchConfigurationTemplate, new.ID.ValueString(), err)
+
+			return
+		}
+
+		response.Diagnostics.Append(flex.Flatten(ctx, output.LaunchConfigurationTemplate, &new, launchConfigurationTemplateFlexOpt)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *launchConfigurationTemplateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data launchConfigurationTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DRSClient(ctx)
+
+	tflog.Debug(ctx, "deleting DRS Launch Configuration Template", map[string]any{
+		names.AttrID: data.ID.ValueString(),
+	})
+
+	input := &drs.DeleteLaunchConfigurationTemplateInput{
+		LaunchConfigurationTemplateID: data.ID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteLaunchConfigurationTemplate(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.DRS, create.ErrActionDeleting, ResNameLaunchConfigurationTemplate, data.ID.ValueString(), err)
+
+		return
+	}
+}
+
+func findLaunchConfigurationTemplate(ctx context.Context, conn *drs.Client, input *drs.DescribeLaunchConfigurationTemplatesInput) (*awstypes.LaunchConfigurationTemplate, error) {
+	output, err := findLaunchConfigurationTemplates(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findLaunchConfigurationTemplates(ctx context.Context, conn *drs.Client, input *drs.DescribeLaunchConfigurationTemplatesInput) ([]awstypes.LaunchConfigurationTemplate, error) {
+	var output []awstypes.LaunchConfigurationTemplate
+
+	pages := drs.NewDescribeLaunchConfigurationTemplatesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+func findLaunchConfigurationTemplateByID(ctx context.Context, conn *drs.Client, id string) (*awstypes.LaunchConfigurationTemplate, error) {
+	input := &drs.DescribeLaunchConfigurationTemplatesInput{
+		LaunchConfigurationTemplateIDs: []string{id},
+	}
+
+	output, err := findLaunchConfigurationTemplate(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.LaunchConfigurationTemplateID) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+type launchConfigurationTemplateResourceModel struct {
+	ARN                                 types.String                                                     `tfsdk:"arn"`
+	CopyPrivateIP                       types.Bool                                                       `tfsdk:"copy_private_ip"`
+	CopyTags                            types.Bool                                                       `tfsdk:"copy_tags"`
+	ExportBucketARN                     fwtypes.ARN                                                      `tfsdk:"export_bucket_arn"`
+	ID                                  types.String                                                     `tfsdk:"id"`
+	LaunchDisposition                   fwtypes.StringEnum[awstypes.LaunchDisposition]                   `tfsdk:"launch_disposition"`
+	LaunchIntoSourceInstance            types.Bool                                                       `tfsdk:"launch_into_source_instance"`
+	Licensing                           fwtypes.ListNestedObjectValueOf[licensingModel]                  `tfsdk:"licensing"`
+	PostLaunchEnabled                   types.Bool                                                       `tfsdk:"post_launch_enabled"`
+	Tags                                tftags.Map                                                       `tfsdk:"tags"`
+	TagsAll                             tftags.Map                                                       `tfsdk:"tags_all"`
+	TargetInstanceTypeRightSizingMethod fwtypes.StringEnum[awstypes.TargetInstanceTypeRightSizingMethod] `tfsdk:"target_instance_type_right_sizing_method"`
+}
+
+type licensingModel struct {
+	OSBYOL types.Bool `tfsdk:"os_byol"`
+}
+
+func launchConfigurationTemplateHasChanges(_ context.Context, plan, state launchConfigurationTemplateResourceModel) bool {
+	return !plan.CopyPrivateIP.Equal(state.CopyPrivateIP) ||
+		!plan.CopyTags.Equal(state.CopyTags) ||
+		!plan.ExportBucketARN.Equal(state.ExportBucketARN) ||
+		!plan.LaunchDisposition.Equal(state.LaunchDisposition) ||
+		!plan.LaunchIntoSourceInstance.Equal(state.LaunchIntoSourceInstance) ||
+		!plan.Licensing.Equal(state.Licensing) ||
+		!plan.PostLaunchEnabled.Equal(state.PostLaunchEnabled) ||
+		!plan.TargetInstanceTypeRightSizingMethod.Equal(state.TargetInstanceTypeRightSizingMethod)
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package drs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/drs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdrs "github.com/hashicorp/terraform-provider-aws/internal/service/drs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// TestAccDRSLaunchConfigurationTemplate_serial serializes the tests
+// since launch configuration templates are applied account-wide.
+func TestAccDRSLaunchConfigurationTemplate_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		acctest.CtBasic:      testAccLaunchConfigurationTemplate_basic,
+		acctest.CtDisappears: testAccLaunchConfigurationTemplate_disappears,
+		"tags":               testAccDRSLaunchConfigurationTemplate_tagsSerial,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 5*time.Second)
+}
+
+func testAccLaunchConfigurationTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_drs_launch_configuration_template.test"
+	var v awstypes.LaunchConfigurationTemplate
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DRSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchConfigurationTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_basic("STOPPED", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARNFormat(ctx, resourceName, names.AttrARN, "drs", "launch-configuration-template/{id}"),
+					resource.TestCheckResourceAttr(resourceName, "copy_private_ip", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "copy_tags", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "launch_disposition", "STOPPED"),
+					resource.TestCheckResourceAttr(resourceName, "licensing.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "licensing.0.os_byol", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "post_launch_enabled", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_basic("STARTED", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "launch_disposition", "STARTED"),
+					resource.TestCheckResourceAttr(resourceName, "licensing.0.os_byol", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func testAccLaunchConfigurationTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_drs_launch_configuration_template.test"
+	var v awstypes.LaunchConfigurationTemplate
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DRSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchConfigurationTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfigurationTemplateConfig_basic("STOPPED", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchConfigurationTemplateExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdrs.ResourceLaunchConfigurationTemplate, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLaunchConfigurationTemplateExists(ctx context.Context, n string, v *awstypes.LaunchConfigurationTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DRSClient(ctx)
+
+		output, err := tfdrs.FindLaunchConfigurationTemplateByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckLaunchConfigurationTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DRSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_drs_launch_configuration_template" {
+				continue
+			}
+
+			_, err := tfdrs.FindLaunchConfigurationTemplateByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DRS Launch Configuration Template (%s) still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccLaunchConfigurationTemplateConfig_basic(launchDisposition string, osBYOL bool) string {
+	return fmt.Sprintf(`
+resource "aws_drs_launch_configuration_template" "test" {
+  copy_private_ip     = false
+  copy_tags           = true
+  launch_disposition  = %[1]q
+  post_launch_enabled = false
+
+  licensing = [{
+    os_byol = %[2]t
+  }]
+}
+`, launchDisposition, osBYOL)
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package drs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/drs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/drs/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_drs_recovery_instances", name="Recovery Instances")
+func newRecoveryInstancesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &recoveryInstancesDataSource{}, nil
+}
+
+type recoveryInstancesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *recoveryInstancesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"recovery_instance_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"recovery_instances": framework.DataSourceComputedListOfObjectAttribute[recoveryInstanceModel](ctx),
+			"source_server_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (d *recoveryInstancesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data recoveryInstancesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().DRSClient(ctx)
+
+	input := &drs.DescribeRecoveryInstancesInput{
+		Filters: &awstypes.DescribeRecoveryInstancesRequestFilters{
+			RecoveryInstanceIDs: fwflex.ExpandFrameworkStringValueList(ctx, data.RecoveryInstanceIDs),
+			SourceServerIDs:     fwflex.ExpandFrameworkStringValueList(ctx, data.SourceServerIDs),
+		},
+	}
+
+	output, err := findRecoveryInstances(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.DRS, create.ErrActionReading, DSNameRecoveryInstances, "", err), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.RecoveryInstances, fwflex.WithNoIgnoredFieldNames())...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findRecoveryInstances(ctx context.Context, conn *drs.Client, input *drs.DescribeRecoveryInstancesInput) ([]awstypes.RecoveryInstance, error) {
+	var output []awstypes.RecoveryInstance
+
+	pages := drs.NewDescribeRecoveryInstancesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+type recoveryInstancesDataSourceModel struct {
+	RecoveryInstanceIDs fwtypes.ListOfString                                   `tfsdk:"recovery_instance_ids"`
+	RecoveryInstances   fwtypes.ListNestedObjectValueOf[recoveryInstanceModel] `tfsdk:"recovery_instances"`
+	SourceServerIDs     fwtypes.ListOfString                                   `tfsdk:"source_server_ids"`
+}
+
+type recoveryInstanceModel struct {
+	AgentVersion                types.String                                   `tfsdk:"agent_version"`
+	ARN                         types.String                                   `tfsdk:"arn"`
+	EC2InstanceID               types.String                                   `tfsdk:"ec2_instance_id"`
+	EC2InstanceState            fwtypes.StringEnum[awstypes.EC2InstanceState]  `tfsdk:"ec2_instance_state"`
+	IsDrill                     types.Bool                                     `tfsdk:"is_drill"`
+	JobID                       types.String                                   `tfsdk:"job_id"`
+	OriginAvailabilityZone      types.String                                   `tfsdk:"origin_availability_zone"`
+	OriginEnvironment           fwtypes.StringEnum[awstypes.OriginEnvironment] `tfsdk:"origin_environment"`
+	PointInTimeSnapshotDateTime types.String                                   `tfsdk:"point_in_time_snapshot_date_time"`
+	RecoveryInstanceID          types.String                                   `tfsdk:"recovery_instance_id"`
+	SourceOutpostARN            types.String                                   `tfsdk:"source_outpost_arn"`
+	SourceServerID              types.String                                   `tfsdk:"source_server_id"`
+	Tags                        fwtypes.MapOfString                            `tfsdk:"tags"`
+}
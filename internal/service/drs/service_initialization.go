@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package drs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/drs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/drs/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_drs_service_initialization", name="Service Initialization")
+func newServiceInitializationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &serviceInitializationResource{}
+
+	return r, nil
+}
+
+// serviceInitializationResource initializes Elastic Disaster Recovery in the current Region.
+// There is no API to uninitialize the service, so Delete only removes the resource from state.
+type serviceInitializationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithNoOpDelete
+	framework.WithImportByID
+}
+
+func (r *serviceInitializationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+		},
+	}
+}
+
+func (r *serviceInitializationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data serviceInitializationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DRSClient(ctx)
+
+	input := &drs.InitializeServiceInput{}
+
+	_, err := conn.InitializeService(ctx, input)
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.DRS, create.ErrActionCreating, ResNameServiceInitialization, r.Meta().Region(ctx), err)
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = flex.StringValueToFramework(ctx, r.Meta().Region(ctx))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *serviceInitializationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data serviceInitializationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DRSClient(ctx)
+
+	err := findServiceInitialization(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.DRS, create.ErrActionReading, ResNameServiceInitialization, data.ID.ValueString(), err)
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// findServiceInitialization returns a NotFoundError if Elastic Disaster Recovery has not been initialized.
+// DRS has no API to query initialization state directly, so any cheap read call is used as a probe.
+func findServiceInitialization(ctx context.Context, conn *drs.Client) error {
+	input := &drs.DescribeReplicationConfigurationTemplatesInput{
+		MaxResults: aws.Int32(1),
+	}
+
+	_, err := conn.DescribeReplicationConfigurationTemplates(ctx, input)
+
+	if errs.IsA[*awstypes.UninitializedAccountException](err) {
+		return &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	return err
+}
+
+type serviceInitializationResourceModel struct {
+	ID types.String `tfsdk:"id"`
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package drs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdrs "github.com/hashicorp/terraform-provider-aws/internal/service/drs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDRSServiceInitialization_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_drs_service_initialization.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DRSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceInitializationConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceInitializationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrID, acctest.Region()),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckServiceInitializationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DRSClient(ctx)
+
+		return tfdrs.FindServiceInitialization(ctx, conn)
+	}
+}
+
+const testAccServiceInitializationConfig_basic = `
+resource "aws_drs_service_initialization" "test" {}
+`
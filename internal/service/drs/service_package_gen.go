@@ -15,19 +15,60 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newRecoveryInstancesDataSource,
+			TypeName: "aws_drs_recovery_instances",
+			Name:     "Recovery Instances",
+			Region:   types.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newSourceServersDataSource,
+			TypeName: "aws_drs_source_servers",
+			Name:     "Source Servers",
+			Region:   types.ResourceRegionDefault(),
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory:  newLaunchConfigurationTemplateResource,
+			TypeName: "aws_drs_launch_configuration_template",
+			Name:     "Launch Configuration Template",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  newReplicationConfigurationTemplateResource,
 			TypeName: "aws_drs_replication_configuration_template",
 			Name:     "Replication Configuration Template",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  newServiceInitializationResource,
+			TypeName: "aws_drs_service_initialization",
+			Name:     "Service Initialization",
+			Region:   types.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newSourceServerLaunchConfigurationResource,
+			TypeName: "aws_drs_source_server_launch_configuration",
+			Name:     "Source Server Launch Configuration",
+			Region:   types.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newSourceServerReplicationConfigurationResource,
+			TypeName: "aws_drs_source_server_replication_configuration",
+			Name:     "Source Server Replication Configuration",
+			Region:   types.ResourceRegionDefault(),
+		},
 	}
 }
 
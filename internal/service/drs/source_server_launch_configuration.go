@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package drs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/drs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/drs/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_drs_source_server_launch_configuration", name="Source Server Launch Configuration")
+func newSourceServerLaunchConfigurationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &sourceServerLaunchConfigurationResource{}
+
+	return r, nil
+}
+
+// sourceServerLaunchConfigurationResource manages the launch settings of an existing source server.
+// The settings cannot be removed, so Delete only removes the resource from state.
+type sourceServerLaunchConfigurationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (r *sourceServerLaunchConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"copy_private_ip": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"copy_tags": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ec2_launch_template_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"launch_disposition": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LaunchDisposition](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"launch_into_instance_properties": framework.ResourceOptionalComputedListOfObjectsAttribute[launchIntoInstancePropertiesModel](ctx, 1, nil, listplanmodifier.UseStateForUnknown()),
+			"licensing":                       framework.ResourceOptionalComputedListOfObjectsAttribute[licensingModel](ctx, 1, nil, listplanmodifier.UseStateForUnknown()),
+			"post_launch_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_server_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_instance_type_right_sizing_method": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TargetInstanceTypeRightSizingMethod](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *sourceServerLaunchConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data sourceServerLaunchConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DRSClient(ctx)
+
+	id := data.SourceServerID.ValueString()
+	var input drs.UpdateLaunchConfigurationInput
+	response.Diagnostics.Append(flex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.UpdateLaunchConfiguration(ctx, &input)
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.DRS, create.ErrActionCreating, ResNameSourceServerLaunchConfiguration, id, err)
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *sourceServerLaunchConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data sourceServerLaunchConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DRSClient(ctx)
+
+	output, err := findLaunchConfigurationBySourceServerID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.DRS, create.ErrActionReading, ResNameSourceServerLaunchConfiguration, data.ID.ValueString(), err)
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *sourceServerLaunchConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new sourceServerLaunchConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DRSClient(ctx)
+
+	var input drs.UpdateLaunchConfigurationInput
+	response.Diagnostics.Append(flex.Expand(ctx, new, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.UpdateLaunchConfiguration(ctx, &input)
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.DRS, create.ErrActionUpdating, ResNameSourceServerLaunchConfiguration, new.ID.ValueString(), err)
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, output, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *sourceServerLaunchConfigurationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("source_server_id"), request.ID)...)
+}
+
+func findLaunchConfigurationBySourceServerID(ctx context.Context, conn *drs.Client, id string) (*drs.GetLaunchConfigurationOutput, error) {
+	input := &drs.GetLaunchConfigurationInput{
+		SourceServerID: &id,
+	}
+
+	output, err := conn.GetLaunchConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type sourceServerLaunchConfigurationResourceModel struct {
+	CopyPrivateIP                       types.Bool                                                         `tfsdk:"copy_private_ip"`
+	CopyTags                            types.Bool                                                         `tfsdk:"copy_tags"`
+	EC2LaunchTemplateID                 types.String                                                       `tfsdk:"ec2_launch_template_id"`
+	ID                                  types.String                                                       `tfsdk:"id"`
+	LaunchDisposition                   fwtypes.StringEnum[awstypes.LaunchDisposition]                     `tfsdk:"launch_disposition"`
+	LaunchIntoInstanceProperties        fwtypes.ListNestedObjectValueOf[launchIntoInstancePropertiesModel] `tfsdk:"launch_into_instance_properties"`
+	Licensing                           fwtypes.ListNestedObjectValueOf[licensingModel]                    `tfsdk:"licensing"`
+	PostLaunchEnabled                   types.Bool                                                         `tfsdk:"post_launch_enabled"`
+	SourceServerID                      types.String                                                       `tfsdk:"source_server_id"`
+	TargetInstanceTypeRightSizingMethod fwtypes.StringEnum[awstypes.TargetInstanceTypeRightSizingMethod]   `tfsdk:"target_instance_type_right_sizing_method"`
+}
+
+type launchIntoInstancePropertiesModel struct {
+	LaunchIntoEC2InstanceID types.String `tfsdk:"launch_into_ec2_instance_id"`
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package drs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/drs"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdrs "github.com/hashicorp/terraform-provider-aws/internal/service/drs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Source servers are registered by the AWS Replication Agent and cannot be created by this provider.
+const envVarSourceServerID = "DRS_SOURCE_SERVER_ID"
+
+func TestAccDRSSourceServerLaunchConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_drs_source_server_launch_configuration.test"
+	sourceServerID := acctest.SkipIfEnvVarNotSet(t, envVarSourceServerID)
+	var v drs.GetLaunchConfigurationOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DRSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceServerLaunchConfigurationConfig_basic(sourceServerID, "STOPPED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSourceServerLaunchConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "ec2_launch_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "launch_disposition", "STOPPED"),
+					resource.TestCheckResourceAttr(resourceName, "source_server_id", sourceServerID),
+					resource.TestCheckResourceAttr(resourceName, "target_instance_type_right_sizing_method", "NONE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSourceServerLaunchConfigurationConfig_basic(sourceServerID, "STARTED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSourceServerLaunchConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "launch_disposition", "STARTED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSourceServerLaunchConfigurationExists(ctx context.Context, n string, v *drs.GetLaunchConfigurationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DRSClient(ctx)
+
+		output, err := tfdrs.FindLaunchConfigurationBySourceServerID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSourceServerLaunchConfigurationConfig_basic(sourceServerID, launchDisposition string) string {
+	return fmt.Sprintf(`
+resource "aws_drs_source_server_launch_configuration" "test" {
+  source_server_id                         = %[1]q
+  launch_disposition                       = %[2]q
+  target_instance_type_right_sizing_method = "NONE"
+}
+`, sourceServerID, launchDisposition)
+}
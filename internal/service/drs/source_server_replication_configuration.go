@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package drs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/drs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/drs/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_drs_source_server_replication_configuration", name="Source Server Replication Configuration")
+func newSourceServerReplicationConfigurationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &sourceServerReplicationConfigurationResource{}
+
+	return r, nil
+}
+
+// sourceServerReplicationConfigurationResource manages the replication settings of an existing source server.
+// The settings cannot be removed, so Delete only removes the resource from state.
+type sourceServerReplicationConfigurationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (r *sourceServerReplicationConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"associate_default_security_group": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auto_replicate_new_disks": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bandwidth_throttling": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"create_public_ip": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data_plane_routing": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ReplicationConfigurationDataPlaneRouting](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_large_staging_disk_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ReplicationConfigurationDefaultLargeStagingDiskType](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ebs_encryption": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ReplicationConfigurationEbsEncryption](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ebs_encryption_key_arn": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"pit_policy": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[pitPolicy](ctx),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[pitPolicy](ctx),
+				},
+			},
+			"replicated_disks": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[replicatedDiskModel](ctx),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[replicatedDiskModel](ctx),
+				},
+			},
+			"replication_server_instance_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"replication_servers_security_groups_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_server_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"staging_area_subnet_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"staging_area_tags": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"use_dedicated_replication_server": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *sourceServerReplicationConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data sourceServerReplicationConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DRSClient(ctx)
+
+	id := data.SourceServerID.ValueString()
+	var input drs.UpdateReplicationConfigurationInput
+	response.Diagnostics.Append(flex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.UpdateReplicationConfiguration(ctx, &input)
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.DRS, create.ErrActionCreating, ResNameSourceServerReplicationConfiguration, id, err)
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *sourceServerReplicationConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data sourceServerReplicationConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DRSClient(ctx)
+
+	output, err := findReplicationConfigurationBySourceServerID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.DRS, create.ErrActionReading, ResNameSourceServerReplicationConfiguration, data.ID.ValueString(), err)
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *sourceServerReplicationConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new sourceServerReplicationConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DRSClient(ctx)
+
+	var input drs.UpdateReplicationConfigurationInput
+	response.Diagnostics.Append(flex.Expand(ctx, new, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.UpdateReplicationConfiguration(ctx, &input)
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.DRS, create.ErrActionUpdating, ResNameSourceServerReplicationConfiguration, new.ID.ValueString(), err)
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, output, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *sourceServerReplicationConfigurationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("source_server_id"), request.ID)...)
+}
+
+func findReplicationConfigurationBySourceServerID(ctx context.Context, conn *drs.Client, id string) (*drs.GetReplicationConfigurationOutput, error) {
+	input := &drs.GetReplicationConfigurationInput{
+		SourceServerID: &id,
+	}
+
+	output, err := conn.GetReplicationConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type sourceServerReplicationConfigurationResourceModel struct {
+	AssociateDefaultSecurityGroup       types.Bool                                                                       `tfsdk:"associate_default_security_group"`
+	AutoReplicateNewDisks               types.Bool                                                                       `tfsdk:"auto_replicate_new_disks"`
+	BandwidthThrottling                 types.Int64                                                                      `tfsdk:"bandwidth_throttling"`
+	CreatePublicIP                      types.Bool                                                                       `tfsdk:"create_public_ip"`
+	DataPlaneRouting                    fwtypes.StringEnum[awstypes.ReplicationConfigurationDataPlaneRouting]            `tfsdk:"data_plane_routing"`
+	DefaultLargeStagingDiskType         fwtypes.StringEnum[awstypes.ReplicationConfigurationDefaultLargeStagingDiskType] `tfsdk:"default_large_staging_disk_type"`
+	EBSEncryption                       fwtypes.StringEnum[awstypes.ReplicationConfigurationEbsEncryption]               `tfsdk:"ebs_encryption"`
+	EBSEncryptionKeyARN                 types.String                                                                     `tfsdk:"ebs_encryption_key_arn"`
+	ID                                  types.String                                                                     `tfsdk:"id"`
+	PitPolicy                           fwtypes.ListNestedObjectValueOf[pitPolicy]                                       `tfsdk:"pit_policy"`
+	ReplicatedDisks                     fwtypes.ListNestedObjectValueOf[replicatedDiskModel]                             `tfsdk:"replicated_disks"`
+	ReplicationServerInstanceType       types.String                                                                     `tfsdk:"replication_server_instance_type"`
+	ReplicationServersSecurityGroupsIDs fwtypes.ListOfString                                                             `tfsdk:"replication_servers_security_groups_ids"`
+	SourceServerID                      types.String                                                                     `tfsdk:"source_server_id"`
+	StagingAreaSubnetID                 types.String                                                                     `tfsdk:"staging_area_subnet_id"`
+	StagingAreaTags                     fwtypes.MapOfString                                                              `tfsdk:"staging_area_tags"`
+	UseDedicatedReplicationServer       types.Bool                                                                       `tfsdk:"use_dedicated_replication_server"`
+}
+
+type replicatedDiskModel struct {
+	DeviceName               types.String                                                                       `tfsdk:"device_name"`
+	IOPS                     types.Int64                                                                        `tfsdk:"iops"`
+	IsBootDisk               types.Bool                                                                         `tfsdk:"is_boot_disk"`
+	OptimizedStagingDiskType fwtypes.StringEnum[awstypes.ReplicationConfigurationReplicatedDiskStagingDiskType] `tfsdk:"optimized_staging_disk_type"`
+	StagingDiskType          fwtypes.StringEnum[awstypes.ReplicationConfigurationReplicatedDiskStagingDiskType] `tfsdk:"staging_disk_type"`
+	Throughput               types.Int64                                                                        `tfsdk:"throughput"`
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package drs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/drs"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdrs "github.com/hashicorp/terraform-provider-aws/internal/service/drs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDRSSourceServerReplicationConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_drs_source_server_replication_configuration.test"
+	sourceServerID := acctest.SkipIfEnvVarNotSet(t, envVarSourceServerID)
+	var v drs.GetReplicationConfigurationOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DRSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceServerReplicationConfigurationConfig_basic(sourceServerID, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSourceServerReplicationConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_throttling", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "pit_policy.#"),
+					resource.TestCheckResourceAttrSet(resourceName, "replicated_disks.#"),
+					resource.TestCheckResourceAttr(resourceName, "source_server_id", sourceServerID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSourceServerReplicationConfigurationConfig_basic(sourceServerID, 100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSourceServerReplicationConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_throttling", "100"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSourceServerReplicationConfigurationExists(ctx context.Context, n string, v *drs.GetReplicationConfigurationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DRSClient(ctx)
+
+		output, err := tfdrs.FindReplicationConfigurationBySourceServerID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSourceServerReplicationConfigurationConfig_basic(sourceServerID string, bandwidthThrottling int) string {
+	return fmt.Sprintf(`
+resource "aws_drs_source_server_replication_configuration" "test" {
+  source_server_id     = %[1]q
+  bandwidth_throttling = %[2]d
+}
+`, sourceServerID, bandwidthThrottling)
+}
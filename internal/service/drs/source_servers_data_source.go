@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package drs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/drs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/drs/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_drs_source_servers", name="Source Servers")
+func newSourceServersDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &sourceServersDataSource{}, nil
+}
+
+type sourceServersDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *sourceServersDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"hardware_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"source_server_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"source_servers": framework.DataSourceComputedListOfObjectAttribute[sourceServerModel](ctx),
+			"staging_account_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (d *sourceServersDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data sourceServersDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().DRSClient(ctx)
+
+	input := &drs.DescribeSourceServersInput{
+		Filters: &awstypes.DescribeSourceServersRequestFilters{
+			HardwareId:        data.HardwareID.ValueStringPointer(),
+			SourceServerIDs:   fwflex.ExpandFrameworkStringValueList(ctx, data.SourceServerIDs),
+			StagingAccountIDs: fwflex.ExpandFrameworkStringValueList(ctx, data.StagingAccountIDs),
+		},
+	}
+
+	output, err := findSourceServers(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.DRS, create.ErrActionReading, DSNameSourceServers, "", err), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.SourceServers, fwflex.WithNoIgnoredFieldNames())...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findSourceServers(ctx context.Context, conn *drs.Client, input *drs.DescribeSourceServersInput) ([]awstypes.SourceServer, error) {
+	var output []awstypes.SourceServer
+
+	pages := drs.NewDescribeSourceServersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+type sourceServersDataSourceModel struct {
+	HardwareID        types.String                                       `tfsdk:"hardware_id"`
+	SourceServerIDs   fwtypes.ListOfString                               `tfsdk:"source_server_ids"`
+	SourceServers     fwtypes.ListNestedObjectValueOf[sourceServerModel] `tfsdk:"source_servers"`
+	StagingAccountIDs fwtypes.ListOfString                               `tfsdk:"staging_account_ids"`
+}
+
+type sourceServerModel struct {
+	AgentVersion                     types.String                                      `tfsdk:"agent_version"`
+	ARN                              types.String                                      `tfsdk:"arn"`
+	LastLaunchResult                 fwtypes.StringEnum[awstypes.LastLaunchResult]     `tfsdk:"last_launch_result"`
+	RecoveryInstanceID               types.String                                      `tfsdk:"recovery_instance_id"`
+	ReplicationDirection             fwtypes.StringEnum[awstypes.ReplicationDirection] `tfsdk:"replication_direction"`
+	ReversedDirectionSourceServerARN types.String                                      `tfsdk:"reversed_direction_source_server_arn"`
+	SourceNetworkID                  types.String                                      `tfsdk:"source_network_id"`
+	SourceServerID                   types.String                                      `tfsdk:"source_server_id"`
+	Tags                             fwtypes.MapOfString                               `tfsdk:"tags"`
+}
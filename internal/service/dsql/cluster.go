@@ -0,0 +1,401 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dsql"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dsql/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_dsql_cluster", name="Cluster")
+// @Tags(identifierAttribute="arn")
+func newClusterResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &clusterResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type clusterResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *clusterResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"deletion_protection_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrIdentifier: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"multi_region_properties": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[multiRegionPropertiesModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"clusters": schema.SetAttribute{
+							CustomType: fwtypes.SetOfStringType,
+							Computed:   true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"witness_region": schema.StringAttribute{
+							Optional: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *clusterResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data clusterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSQLClient(ctx)
+
+	input := dsql.CreateClusterInput{
+		ClientToken:               aws.String(sdkid.UniqueId()),
+		DeletionProtectionEnabled: fwflex.BoolFromFramework(ctx, data.DeletionProtectionEnabled),
+		Tags:                      getTagsIn(ctx),
+	}
+
+	multiRegionProperties, diags := data.MultiRegionProperties.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if multiRegionProperties != nil {
+		input.MultiRegionProperties = &awstypes.MultiRegionProperties{
+			WitnessRegion: fwflex.StringFromFramework(ctx, multiRegionProperties.WitnessRegion),
+		}
+	}
+
+	output, err := conn.CreateCluster(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Aurora DSQL Cluster", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	id := aws.ToString(output.Identifier)
+	data.Identifier = fwflex.StringValueToFramework(ctx, id)
+
+	cluster, err := waitClusterCreated(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrIdentifier), data.Identifier) // Set 'identifier' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Aurora DSQL Cluster (%s) create", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, cluster, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *clusterResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data clusterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSQLClient(ctx)
+
+	id := data.Identifier.ValueString()
+	output, err := findClusterByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Aurora DSQL Cluster (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *clusterResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new clusterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSQLClient(ctx)
+
+	if !new.DeletionProtectionEnabled.Equal(old.DeletionProtectionEnabled) {
+		id := new.Identifier.ValueString()
+		input := dsql.UpdateClusterInput{
+			ClientToken:               aws.String(sdkid.UniqueId()),
+			DeletionProtectionEnabled: fwflex.BoolFromFramework(ctx, new.DeletionProtectionEnabled),
+			Identifier:                aws.String(id),
+		}
+
+		_, err := conn.UpdateCluster(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Aurora DSQL Cluster (%s)", id), err.Error())
+
+			return
+		}
+
+		if _, err := waitClusterUpdated(ctx, conn, id, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Aurora DSQL Cluster (%s) update", id), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *clusterResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data clusterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSQLClient(ctx)
+
+	id := data.Identifier.ValueString()
+	input := dsql.DeleteClusterInput{
+		ClientToken: aws.String(sdkid.UniqueId()),
+		Identifier:  aws.String(id),
+	}
+
+	_, err := conn.DeleteCluster(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Aurora DSQL Cluster (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitClusterDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Aurora DSQL Cluster (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func (r *clusterResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrIdentifier), request, response)
+}
+
+func findClusterByID(ctx context.Context, conn *dsql.Client, id string) (*dsql.GetClusterOutput, error) {
+	input := dsql.GetClusterInput{
+		Identifier: aws.String(id),
+	}
+	output, err := findCluster(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.Status; status == awstypes.ClusterStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: &input,
+		}
+	}
+
+	return output, nil
+}
+
+func findCluster(ctx context.Context, conn *dsql.Client, input *dsql.GetClusterInput) (*dsql.GetClusterOutput, error) {
+	output, err := conn.GetCluster(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusCluster(ctx context.Context, conn *dsql.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findClusterByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitClusterCreated(ctx context.Context, conn *dsql.Client, id string, timeout time.Duration) (*dsql.GetClusterOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ClusterStatusCreating),
+		// A cluster created with a witness Region remains in PENDING_SETUP until it is peered.
+		Target:  enum.Slice(awstypes.ClusterStatusActive, awstypes.ClusterStatusIdle, awstypes.ClusterStatusPendingSetup),
+		Refresh: statusCluster(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dsql.GetClusterOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitClusterUpdated(ctx context.Context, conn *dsql.Client, id string, timeout time.Duration) (*dsql.GetClusterOutput, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ClusterStatusUpdating),
+		Target:  enum.Slice(awstypes.ClusterStatusActive, awstypes.ClusterStatusIdle, awstypes.ClusterStatusPendingSetup),
+		Refresh: statusCluster(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dsql.GetClusterOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitClusterDeleted(ctx context.Context, conn *dsql.Client, id string, timeout time.Duration) (*dsql.GetClusterOutput, error) {
+	const (
+		delay = 10 * time.Second
+	)
+	stateConf := &retry.StateChangeConf{
+		// A peered cluster remains in PENDING_DELETE until all linked clusters are deleted.
+		Pending: enum.Slice(awstypes.ClusterStatusDeleting, awstypes.ClusterStatusPendingDelete),
+		Target:  []string{},
+		Refresh: statusCluster(ctx, conn, id),
+		Delay:   delay,
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dsql.GetClusterOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type clusterResourceModel struct {
+	ARN                       types.String                                                `tfsdk:"arn"`
+	DeletionProtectionEnabled types.Bool                                                  `tfsdk:"deletion_protection_enabled"`
+	Identifier                types.String                                                `tfsdk:"identifier"`
+	MultiRegionProperties     fwtypes.ListNestedObjectValueOf[multiRegionPropertiesModel] `tfsdk:"multi_region_properties"`
+	Tags                      tftags.Map                                                  `tfsdk:"tags"`
+	TagsAll                   tftags.Map                                                  `tfsdk:"tags_all"`
+	Timeouts                  timeouts.Value                                              `tfsdk:"timeouts"`
+}
+
+type multiRegionPropertiesModel struct {
+	Clusters      fwtypes.SetOfString `tfsdk:"clusters"`
+	WitnessRegion types.String        `tfsdk:"witness_region"`
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_dsql_cluster", name="Cluster")
+// @Tags(identifierAttribute="arn")
+func newClusterDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &clusterDataSource{}, nil
+}
+
+type clusterDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *clusterDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreationTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"deletion_protection_enabled": schema.BoolAttribute{
+				Computed: true,
+			},
+			names.AttrIdentifier: schema.StringAttribute{
+				Required: true,
+			},
+			"multi_region_properties": framework.DataSourceComputedListOfObjectAttribute[multiRegionPropertiesModel](ctx),
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *clusterDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data clusterDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().DSQLClient(ctx)
+
+	id := data.Identifier.ValueString()
+	output, err := findClusterByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Aurora DSQL Cluster (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type clusterDataSourceModel struct {
+	ARN                       types.String                                                `tfsdk:"arn"`
+	CreationTime              timetypes.RFC3339                                           `tfsdk:"creation_time"`
+	DeletionProtectionEnabled types.Bool                                                  `tfsdk:"deletion_protection_enabled"`
+	Identifier                types.String                                                `tfsdk:"identifier"`
+	MultiRegionProperties     fwtypes.ListNestedObjectValueOf[multiRegionPropertiesModel] `tfsdk:"multi_region_properties"`
+	Status                    types.String                                                `tfsdk:"status"`
+	Tags                      tftags.Map                                                  `tfsdk:"tags"`
+}
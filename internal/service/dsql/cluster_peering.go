@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dsql"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dsql/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_dsql_cluster_peering", name="Cluster Peering")
+func newClusterPeeringResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &clusterPeeringResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type clusterPeeringResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (r *clusterPeeringResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"clusters": schema.SetAttribute{
+				CustomType: fwtypes.SetOfARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrIdentifier: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"witness_region": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *clusterPeeringResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data clusterPeeringResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSQLClient(ctx)
+
+	id := data.Identifier.ValueString()
+	input := dsql.UpdateClusterInput{
+		ClientToken: aws.String(sdkid.UniqueId()),
+		Identifier:  aws.String(id),
+		MultiRegionProperties: &awstypes.MultiRegionProperties{
+			Clusters:      fwflex.ExpandFrameworkStringValueSet(ctx, data.Clusters),
+			WitnessRegion: fwflex.StringFromFramework(ctx, data.WitnessRegion),
+		},
+	}
+
+	_, err := conn.UpdateCluster(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Aurora DSQL Cluster Peering (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitClusterPeeringCreated(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrIdentifier), data.Identifier) // Set 'identifier' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Aurora DSQL Cluster Peering (%s) create", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *clusterPeeringResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data clusterPeeringResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSQLClient(ctx)
+
+	id := data.Identifier.ValueString()
+	output, err := findClusterPeeringByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Aurora DSQL Cluster Peering (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *clusterPeeringResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrIdentifier), request, response)
+}
+
+func findClusterPeeringByID(ctx context.Context, conn *dsql.Client, id string) (*awstypes.MultiRegionProperties, error) {
+	output, err := findClusterByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.MultiRegionProperties == nil || len(output.MultiRegionProperties.Clusters) == 0 {
+		return nil, &retry.NotFoundError{}
+	}
+
+	return output.MultiRegionProperties, nil
+}
+
+func waitClusterPeeringCreated(ctx context.Context, conn *dsql.Client, id string, timeout time.Duration) (*dsql.GetClusterOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ClusterStatusPendingSetup, awstypes.ClusterStatusUpdating),
+		Target:  enum.Slice(awstypes.ClusterStatusActive, awstypes.ClusterStatusIdle),
+		Refresh: statusCluster(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dsql.GetClusterOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type clusterPeeringResourceModel struct {
+	Clusters      fwtypes.SetOfARN `tfsdk:"clusters"`
+	Identifier    types.String     `tfsdk:"identifier"`
+	Timeouts      timeouts.Value   `tfsdk:"timeouts"`
+	WitnessRegion types.String     `tfsdk:"witness_region"`
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDSQLClusterPeering_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	resourceName1 := "aws_dsql_cluster_peering.test1"
+	resourceName2 := "aws_dsql_cluster_peering.test2"
+	clusterResourceName1 := "aws_dsql_cluster.test1"
+	clusterResourceName2 := "aws_dsql_cluster.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 3)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DSQLServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterPeeringConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName1, names.AttrIdentifier, clusterResourceName1, names.AttrIdentifier),
+					resource.TestCheckResourceAttr(resourceName1, "clusters.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName1, "clusters.*", clusterResourceName2, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName1, "witness_region", acctest.ThirdRegion()),
+					resource.TestCheckResourceAttrPair(resourceName2, names.AttrIdentifier, clusterResourceName2, names.AttrIdentifier),
+					resource.TestCheckResourceAttr(resourceName2, "clusters.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName2, "clusters.*", clusterResourceName1, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName2, "witness_region", acctest.ThirdRegion()),
+				),
+			},
+			{
+				ResourceName:                         resourceName1,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName1, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
+				ImportStateVerifyIgnore:              []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func testAccClusterPeeringConfig_basic() string {
+	return fmt.Sprintf(`
+resource "aws_dsql_cluster" "test1" {
+  multi_region_properties {
+    witness_region = %[2]q
+  }
+}
+
+resource "aws_dsql_cluster" "test2" {
+  region = %[1]q
+
+  multi_region_properties {
+    witness_region = %[2]q
+  }
+}
+
+resource "aws_dsql_cluster_peering" "test1" {
+  identifier     = aws_dsql_cluster.test1.identifier
+  clusters       = [aws_dsql_cluster.test2.arn]
+  witness_region = aws_dsql_cluster.test1.multi_region_properties[0].witness_region
+}
+
+resource "aws_dsql_cluster_peering" "test2" {
+  region = %[1]q
+
+  identifier     = aws_dsql_cluster.test2.identifier
+  clusters       = [aws_dsql_cluster.test1.arn]
+  witness_region = aws_dsql_cluster.test2.multi_region_properties[0].witness_region
+}
+`, acctest.AlternateRegion(), acctest.ThirdRegion())
+}
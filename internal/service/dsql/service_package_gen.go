@@ -15,11 +15,37 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newClusterDataSource,
+			TypeName: "aws_dsql_cluster",
+			Name:     "Cluster",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory:  newClusterResource,
+			TypeName: "aws_dsql_cluster",
+			Name:     "Cluster",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newClusterPeeringResource,
+			TypeName: "aws_dsql_cluster_peering",
+			Name:     "Cluster Peering",
+			Region:   types.ResourceRegionDefault(),
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
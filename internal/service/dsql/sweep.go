@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dsql"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_dsql_cluster", sweepClusters)
+}
+
+func sweepClusters(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	var input dsql.ListClustersInput
+	conn := client.DSQLClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := dsql.NewListClustersPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Clusters {
+			id := aws.ToString(v.Identifier)
+
+			cluster, err := findClusterByID(ctx, conn, id)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("reading Aurora DSQL Cluster (%s): %w", id, err)
+			}
+
+			if aws.ToBool(cluster.DeletionProtectionEnabled) {
+				input := dsql.UpdateClusterInput{
+					ClientToken:               aws.String(sdkid.UniqueId()),
+					DeletionProtectionEnabled: aws.Bool(false),
+					Identifier:                aws.String(id),
+				}
+
+				if _, err := conn.UpdateCluster(ctx, &input); err != nil {
+					return nil, fmt.Errorf("updating Aurora DSQL Cluster (%s) DeletionProtectionEnabled: %w", id, err)
+				}
+
+				const (
+					timeout = 30 * time.Minute
+				)
+				if _, err := waitClusterUpdated(ctx, conn, id, timeout); err != nil {
+					return nil, fmt.Errorf("waiting for Aurora DSQL Cluster (%s) update: %w", id, err)
+				}
+			}
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newClusterResource, client,
+				framework.NewAttribute(names.AttrIdentifier, id)))
+		}
+	}
+
+	return sweepResources, nil
+}
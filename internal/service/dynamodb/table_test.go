@@ -342,6 +342,7 @@ func TestUpdateDiffGSI(t *testing.T) {
 
 func TestAccDynamoDBTable_basic(t *testing.T) {
 	ctx := acctest.Context(t)
+	acctest.EmulatorCompatible(t)
 	var conf awstypes.TableDescription
 	resourceName := "aws_dynamodb_table.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -444,6 +445,7 @@ func TestAccDynamoDBTable_deletion_protection(t *testing.T) {
 
 func TestAccDynamoDBTable_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
+	acctest.EmulatorCompatible(t)
 	var conf awstypes.TableDescription
 	resourceName := "aws_dynamodb_table.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
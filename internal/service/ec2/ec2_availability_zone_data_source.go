@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_availability_zone", name="Availability Zone")
+// @Region(overrideEnabled=false)
 func dataSourceAvailabilityZone() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceAvailabilityZoneRead,
@@ -45,6 +45,7 @@ import (
 )
 
 // @SDKResource("aws_instance", name="Instance")
+// @IdentityAttribute("id")
 // @Tags(identifierAttribute="id")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.Instance")
 // @Testing(importIgnore="user_data_replace_on_change")
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @SDKListResource("aws_instance", name="Instance")
+func newInstanceResourceAsListResource() itypes.ListResourceForSDK {
+	return &instanceListResource{}
+}
+
+type instanceListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *instanceListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{}
+}
+
+func (l *instanceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	// Terminated instances cannot be managed.
+	input := ec2.DescribeInstancesInput{
+		Filters: []awstypes.Filter{
+			{
+				Name: aws.String("instance-state-name"),
+				Values: enum.Slice(
+					awstypes.InstanceStateNamePending,
+					awstypes.InstanceStateNameRunning,
+					awstypes.InstanceStateNameShuttingDown,
+					awstypes.InstanceStateNameStopping,
+					awstypes.InstanceStateNameStopped,
+				),
+			},
+		},
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		err := describeInstancesPages(ctx, conn, &input, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+			for _, reservation := range page.Reservations {
+				for _, v := range reservation.Instances {
+					id := aws.ToString(v.InstanceId)
+
+					result := request.NewListResult(ctx)
+					rd := l.ResourceData()
+					rd.SetId(id)
+
+					l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+					if !result.Diagnostics.HasError() && rd.Id() == "" {
+						continue
+					}
+					result.DisplayName = instanceDisplayName(ctx, v)
+
+					if !yield(result) {
+						return false
+					}
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			var result list.ListResult
+			result.Diagnostics.AddError("Listing EC2 Instances", fmt.Sprintf("listing EC2 Instances: %s", err))
+			yield(result)
+		}
+	}
+}
+
+// instanceDisplayName returns the display name for an EC2 Instance: its ID and any `Name` tag value.
+func instanceDisplayName(ctx context.Context, v awstypes.Instance) string {
+	id := aws.ToString(v.InstanceId)
+
+	if name := keyValueTags(ctx, v.Tags).Map()["Name"]; name != "" {
+		return fmt.Sprintf("%s (%s)", name, id)
+	}
+
+	return id
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2Instance_List_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	displayName := regexache.MustCompile(fmt.Sprintf(`^%s \(i-[0-9a-f]+\)$`, rName))
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		// No subnet_id specified requires default VPC with default subnets.
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckHasDefaultVPCDefaultSubnets(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceListConfig_basic(rName),
+			},
+			{
+				Query:  true,
+				Config: testAccInstanceListQuery_basic(false),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("aws_instance.test", 1),
+					querycheck.ExpectIdentity("aws_instance.test", map[string]knownvalue.Check{
+						names.AttrAccountID: knownvalue.StringExact(acctest.AccountID(ctx)),
+						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
+						names.AttrID:        knownvalue.StringRegexp(regexache.MustCompile(`^i-[0-9a-f]+$`)),
+					}),
+					querycheck.ExpectResourceDisplayName("aws_instance.test", queryfilter.ByDisplayName(knownvalue.StringRegexp(displayName)), knownvalue.StringRegexp(displayName)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccEC2Instance_List_includeResource(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	displayName := regexache.MustCompile(fmt.Sprintf(`^%s \(i-[0-9a-f]+\)$`, rName))
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		// No subnet_id specified requires default VPC with default subnets.
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckHasDefaultVPCDefaultSubnets(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceListConfig_basic(rName),
+			},
+			{
+				Query:  true,
+				Config: testAccInstanceListQuery_basic(true),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("aws_instance.test", 1),
+					querycheck.ExpectResourceKnownValues("aws_instance.test", queryfilter.ByDisplayName(knownvalue.StringRegexp(displayName)), []querycheck.KnownValueCheck{
+						{Path: tfjsonpath.New(names.AttrID), KnownValue: knownvalue.StringRegexp(regexache.MustCompile(`^i-[0-9a-f]+$`))},
+						{Path: tfjsonpath.New(names.AttrTags).AtMapKey("Name"), KnownValue: knownvalue.StringExact(rName)},
+						{Path: tfjsonpath.New(names.AttrRegion), KnownValue: knownvalue.StringExact(acctest.Region())},
+						{Path: tfjsonpath.New(names.AttrARN), KnownValue: knownvalue.NotNull()},
+					}),
+				},
+			},
+		},
+	})
+}
+
+func testAccInstanceListConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro", "t1.micro", "m1.small"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccInstanceListQuery_basic(includeResource bool) string {
+	return fmt.Sprintf(`
+provider "aws" {}
+
+list "aws_instance" "test" {
+  provider         = aws
+  include_resource = %[1]t
+}
+`, includeResource)
+}
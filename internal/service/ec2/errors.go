@@ -67,6 +67,8 @@ const (
 	errCodeInvalidLocalGatewayRouteTableVPCAssociationIDNotFound   = "InvalidLocalGatewayRouteTableVpcAssociationID.NotFound"
 	errCodeInvalidNetworkACLEntryNotFound                          = "InvalidNetworkAclEntry.NotFound"
 	errCodeInvalidNetworkACLIDNotFound                             = "InvalidNetworkAclID.NotFound"
+	errCodeInvalidNetworkInsightsAccessScopeAnalysisIdNotFound     = "InvalidNetworkInsightsAccessScopeAnalysisId.NotFound"
+	errCodeInvalidNetworkInsightsAccessScopeIdNotFound             = "InvalidNetworkInsightsAccessScopeId.NotFound"
 	errCodeInvalidNetworkInsightsAnalysisIdNotFound                = "InvalidNetworkInsightsAnalysisId.NotFound"
 	errCodeInvalidNetworkInsightsPathIdNotFound                    = "InvalidNetworkInsightsPathId.NotFound"
 	errCodeInvalidNetworkInterfaceIDNotFound                       = "InvalidNetworkInterfaceID.NotFound"
@@ -85,6 +87,9 @@ const (
 	errCodeInvalidPublicIpv4PoolIDNotFound                         = "InvalidPublicIpv4PoolID.NotFound" // nosemgrep:ci.caps5-in-const-name,ci.caps5-in-var-name
 	errCodeInvalidReservationNotFound                              = "InvalidReservationID.NotFound"
 	errCodeInvalidRouteNotFound                                    = "InvalidRoute.NotFound"
+	errCodeInvalidRouteServerEndpointIdNotFound                    = "InvalidRouteServerEndpointId.NotFound"
+	errCodeInvalidRouteServerIdNotFound                            = "InvalidRouteServerId.NotFound"
+	errCodeInvalidRouteServerPeerIdNotFound                        = "InvalidRouteServerPeerId.NotFound"
 	errCodeInvalidRouteTableIDNotFound                             = "InvalidRouteTableID.NotFound"
 	errCodeInvalidRouteTableIdNotFound                             = "InvalidRouteTableId.NotFound"
 	errCodeInvalidSecurityGroupIDNotFound                          = "InvalidSecurityGroupID.NotFound"
@@ -58,6 +58,8 @@ var (
 	ResourceNetworkACL                                    = resourceNetworkACL
 	ResourceNetworkACLAssociation                         = resourceNetworkACLAssociation
 	ResourceNetworkACLRule                                = resourceNetworkACLRule
+	ResourceNetworkInsightsAccessScope                    = newNetworkInsightsAccessScopeResource
+	ResourceNetworkInsightsAccessScopeAnalysis            = newNetworkInsightsAccessScopeAnalysisResource
 	ResourceNetworkInsightsAnalysis                       = resourceNetworkInsightsAnalysis
 	ResourceNetworkInsightsPath                           = resourceNetworkInsightsPath
 	ResourceNetworkInterface                              = resourceNetworkInterface
@@ -114,6 +116,11 @@ var (
 	ResourceVPCIPv4CIDRBlockAssociation                   = resourceVPCIPv4CIDRBlockAssociation
 	ResourceVPCIPv6CIDRBlockAssociation                   = resourceVPCIPv6CIDRBlockAssociation
 	ResourceVPCPeeringConnection                          = resourceVPCPeeringConnection
+	ResourceVPCRouteServer                                = newVPCRouteServerResource
+	ResourceVPCRouteServerEndpoint                        = newVPCRouteServerEndpointResource
+	ResourceVPCRouteServerPeer                            = newVPCRouteServerPeerResource
+	ResourceVPCRouteServerPropagation                     = newVPCRouteServerPropagationResource
+	ResourceVPCRouteServerVPCAssociation                  = newVPCRouteServerVPCAssociationResource
 	ResourceVPNConnection                                 = resourceVPNConnection
 	ResourceVPNConnectionRoute                            = resourceVPNConnectionRoute
 	ResourceVPNGateway                                    = resourceVPNGateway
@@ -179,6 +186,9 @@ var (
 	FindNetworkACLAssociationByID                              = findNetworkACLAssociationByID
 	FindNetworkACLByID                                         = findNetworkACLByID
 	FindNetworkACLEntryByThreePartKey                          = findNetworkACLEntryByThreePartKey
+	FindNetworkACLRuleNumbersByID                              = findNetworkACLRuleNumbersByID
+	FindNetworkInsightsAccessScopeAnalysisByID                 = findNetworkInsightsAccessScopeAnalysisByID
+	FindNetworkInsightsAccessScopeByID                         = findNetworkInsightsAccessScopeByID
 	FindNetworkInsightsAnalysisByID                            = findNetworkInsightsAnalysisByID
 	FindNetworkInsightsPathByID                                = findNetworkInsightsPathByID
 	FindNetworkInterfaceByID                                   = findNetworkInterfaceByID
@@ -190,11 +200,18 @@ var (
 	FindRouteByIPv4Destination                                 = findRouteByIPv4Destination
 	FindRouteByIPv6Destination                                 = findRouteByIPv6Destination
 	FindRouteByPrefixListIDDestination                         = findRouteByPrefixListIDDestination
+	FindRouteServerAssociationByTwoPartKey                     = findRouteServerAssociationByTwoPartKey
+	FindRouteServerByID                                        = findRouteServerByID
+	FindRouteServerEndpointByID                                = findRouteServerEndpointByID
+	FindRouteServerPeerByID                                    = findRouteServerPeerByID
+	FindRouteServerPropagationByTwoPartKey                     = findRouteServerPropagationByTwoPartKey
 	FindRouteTableAssociationByID                              = findRouteTableAssociationByID
 	FindRouteTableByID                                         = findRouteTableByID
+	FindRouteTableRoutesExclusiveRoutesByID                    = findRouteTableRoutesExclusiveRoutesByID
 	FindSecurityGroupByID                                      = findSecurityGroupByID
 	FindSecurityGroupEgressRuleByID                            = findSecurityGroupEgressRuleByID
 	FindSecurityGroupIngressRuleByID                           = findSecurityGroupIngressRuleByID
+	FindSecurityGroupRuleIDsBySecurityGroupID                  = findSecurityGroupRuleIDsBySecurityGroupID
 	FindSnapshot                                               = findSnapshot
 	FindSnapshotByID                                           = findSnapshotByID
 	FindSpotDatafeedSubscription                               = findSpotDatafeedSubscription
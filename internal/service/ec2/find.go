@@ -6603,6 +6603,168 @@ func findNetworkInsightsPathByID(ctx context.Context, conn *ec2.Client, id strin
 	return output, nil
 }
 
+func findNetworkInsightsAccessScope(ctx context.Context, conn *ec2.Client, input *ec2.DescribeNetworkInsightsAccessScopesInput) (*awstypes.NetworkInsightsAccessScope, error) {
+	output, err := findNetworkInsightsAccessScopes(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findNetworkInsightsAccessScopes(ctx context.Context, conn *ec2.Client, input *ec2.DescribeNetworkInsightsAccessScopesInput) ([]awstypes.NetworkInsightsAccessScope, error) {
+	var output []awstypes.NetworkInsightsAccessScope
+
+	pages := ec2.NewDescribeNetworkInsightsAccessScopesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsAccessScopeIdNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.NetworkInsightsAccessScopes...)
+	}
+
+	return output, nil
+}
+
+func findNetworkInsightsAccessScopeByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.NetworkInsightsAccessScope, error) {
+	input := ec2.DescribeNetworkInsightsAccessScopesInput{
+		NetworkInsightsAccessScopeIds: []string{id},
+	}
+
+	output, err := findNetworkInsightsAccessScope(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.NetworkInsightsAccessScopeId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: &input,
+		}
+	}
+
+	return output, nil
+}
+
+func findNetworkInsightsAccessScopeContentByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.NetworkInsightsAccessScopeContent, error) {
+	input := ec2.GetNetworkInsightsAccessScopeContentInput{
+		NetworkInsightsAccessScopeId: aws.String(id),
+	}
+
+	output, err := conn.GetNetworkInsightsAccessScopeContent(ctx, &input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsAccessScopeIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.NetworkInsightsAccessScopeContent == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return output.NetworkInsightsAccessScopeContent, nil
+}
+
+func findNetworkInsightsAccessScopeAnalysis(ctx context.Context, conn *ec2.Client, input *ec2.DescribeNetworkInsightsAccessScopeAnalysesInput) (*awstypes.NetworkInsightsAccessScopeAnalysis, error) {
+	output, err := findNetworkInsightsAccessScopeAnalyses(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findNetworkInsightsAccessScopeAnalyses(ctx context.Context, conn *ec2.Client, input *ec2.DescribeNetworkInsightsAccessScopeAnalysesInput) ([]awstypes.NetworkInsightsAccessScopeAnalysis, error) {
+	var output []awstypes.NetworkInsightsAccessScopeAnalysis
+
+	pages := ec2.NewDescribeNetworkInsightsAccessScopeAnalysesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsAccessScopeAnalysisIdNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.NetworkInsightsAccessScopeAnalyses...)
+	}
+
+	return output, nil
+}
+
+func findNetworkInsightsAccessScopeAnalysisByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.NetworkInsightsAccessScopeAnalysis, error) {
+	input := ec2.DescribeNetworkInsightsAccessScopeAnalysesInput{
+		NetworkInsightsAccessScopeAnalysisIds: []string{id},
+	}
+
+	output, err := findNetworkInsightsAccessScopeAnalysis(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.NetworkInsightsAccessScopeAnalysisId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: &input,
+		}
+	}
+
+	return output, nil
+}
+
+func findNetworkInsightsAccessScopeAnalysisFindingsByID(ctx context.Context, conn *ec2.Client, id string) ([]awstypes.AccessScopeAnalysisFinding, error) {
+	input := ec2.GetNetworkInsightsAccessScopeAnalysisFindingsInput{
+		NetworkInsightsAccessScopeAnalysisId: aws.String(id),
+	}
+	var output []awstypes.AccessScopeAnalysisFinding
+
+	pages := ec2.NewGetNetworkInsightsAccessScopeAnalysisFindingsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsAccessScopeAnalysisIdNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: &input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AnalysisFindings...)
+	}
+
+	return output, nil
+}
+
 func findCapacityBlockOffering(ctx context.Context, conn *ec2.Client, input *ec2.DescribeCapacityBlockOfferingsInput) (*awstypes.CapacityBlockOffering, error) {
 	output, err := findCapacityBlockOfferings(ctx, conn, input)
 
@@ -6717,3 +6879,268 @@ func findVPCBlockPublicAccessExclusionByID(ctx context.Context, conn *ec2.Client
 
 	return output, nil
 }
+
+func findRouteServer(ctx context.Context, conn *ec2.Client, input *ec2.DescribeRouteServersInput) (*awstypes.RouteServer, error) {
+	output, err := findRouteServers(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findRouteServers(ctx context.Context, conn *ec2.Client, input *ec2.DescribeRouteServersInput) ([]awstypes.RouteServer, error) {
+	var output []awstypes.RouteServer
+
+	pages := ec2.NewDescribeRouteServersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteServerIdNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: &input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.RouteServers...)
+	}
+
+	return output, nil
+}
+
+func findRouteServerByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.RouteServer, error) {
+	input := ec2.DescribeRouteServersInput{
+		RouteServerIds: []string{id},
+	}
+
+	output, err := findRouteServer(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := output.State; state == awstypes.RouteServerStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: &input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.RouteServerId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: &input,
+		}
+	}
+
+	return output, nil
+}
+
+func findRouteServerEndpoint(ctx context.Context, conn *ec2.Client, input *ec2.DescribeRouteServerEndpointsInput) (*awstypes.RouteServerEndpoint, error) {
+	output, err := findRouteServerEndpoints(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findRouteServerEndpoints(ctx context.Context, conn *ec2.Client, input *ec2.DescribeRouteServerEndpointsInput) ([]awstypes.RouteServerEndpoint, error) {
+	var output []awstypes.RouteServerEndpoint
+
+	pages := ec2.NewDescribeRouteServerEndpointsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteServerEndpointIdNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: &input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.RouteServerEndpoints...)
+	}
+
+	return output, nil
+}
+
+func findRouteServerEndpointByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.RouteServerEndpoint, error) {
+	input := ec2.DescribeRouteServerEndpointsInput{
+		RouteServerEndpointIds: []string{id},
+	}
+
+	output, err := findRouteServerEndpoint(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := output.State; state == awstypes.RouteServerEndpointStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: &input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.RouteServerEndpointId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: &input,
+		}
+	}
+
+	return output, nil
+}
+
+func findRouteServerPeer(ctx context.Context, conn *ec2.Client, input *ec2.DescribeRouteServerPeersInput) (*awstypes.RouteServerPeer, error) {
+	output, err := findRouteServerPeers(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findRouteServerPeers(ctx context.Context, conn *ec2.Client, input *ec2.DescribeRouteServerPeersInput) ([]awstypes.RouteServerPeer, error) {
+	var output []awstypes.RouteServerPeer
+
+	pages := ec2.NewDescribeRouteServerPeersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteServerPeerIdNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: &input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.RouteServerPeers...)
+	}
+
+	return output, nil
+}
+
+func findRouteServerPeerByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.RouteServerPeer, error) {
+	input := ec2.DescribeRouteServerPeersInput{
+		RouteServerPeerIds: []string{id},
+	}
+
+	output, err := findRouteServerPeer(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := output.State; state == awstypes.RouteServerPeerStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: &input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.RouteServerPeerId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: &input,
+		}
+	}
+
+	return output, nil
+}
+
+func findRouteServerAssociations(ctx context.Context, conn *ec2.Client, input *ec2.GetRouteServerAssociationsInput) ([]awstypes.RouteServerAssociation, error) {
+	output, err := conn.GetRouteServerAssociations(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteServerIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RouteServerAssociations, nil
+}
+
+func findRouteServerAssociationByTwoPartKey(ctx context.Context, conn *ec2.Client, routeServerID, vpcID string) (*awstypes.RouteServerAssociation, error) {
+	input := ec2.GetRouteServerAssociationsInput{
+		RouteServerId: aws.String(routeServerID),
+	}
+
+	output, err := findRouteServerAssociations(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output = tfslices.Filter(output, func(v awstypes.RouteServerAssociation) bool {
+		return aws.ToString(v.VpcId) == vpcID
+	})
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findRouteServerPropagations(ctx context.Context, conn *ec2.Client, input *ec2.GetRouteServerPropagationsInput) ([]awstypes.RouteServerPropagation, error) {
+	output, err := conn.GetRouteServerPropagations(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteServerIdNotFound, errCodeInvalidRouteTableIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RouteServerPropagations, nil
+}
+
+func findRouteServerPropagationByTwoPartKey(ctx context.Context, conn *ec2.Client, routeServerID, routeTableID string) (*awstypes.RouteServerPropagation, error) {
+	input := ec2.GetRouteServerPropagationsInput{
+		RouteServerId: aws.String(routeServerID),
+		RouteTableId:  aws.String(routeTableID),
+	}
+
+	output, err := findRouteServerPropagations(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output = tfslices.Filter(output, func(v awstypes.RouteServerPropagation) bool {
+		return aws.ToString(v.RouteTableId) == routeTableID
+	})
+
+	return tfresource.AssertSingleValueResult(output)
+}
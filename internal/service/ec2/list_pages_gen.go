@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeSpotFleetInstances,DescribeSpotFleetRequestHistory,DescribeVpcBlockPublicAccessExclusions,DescribeVpcEndpointServices"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeInstances,DescribeSpotFleetInstances,DescribeSpotFleetRequestHistory,DescribeVpcBlockPublicAccessExclusions,DescribeVpcEndpointServices"; DO NOT EDIT.
 
 package ec2
 
@@ -9,6 +9,22 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 )
 
+func describeInstancesPages(ctx context.Context, conn *ec2.Client, input *ec2.DescribeInstancesInput, fn func(*ec2.DescribeInstancesOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeInstances(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
 func describeSpotFleetInstancesPages(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSpotFleetInstancesInput, fn func(*ec2.DescribeSpotFleetInstancesOutput, bool) bool) error {
 	for {
 		output, err := conn.DescribeSpotFleetInstances(ctx, input)
@@ -49,6 +49,7 @@ var (
 )
 
 // @SDKResource("aws_vpc", name="VPC")
+// @IdentityAttribute("id")
 // @Tags(identifierAttribute="id")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.Vpc")
 // @Testing(generator=false)
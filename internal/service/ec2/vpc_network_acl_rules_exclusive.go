@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_network_acl_rules_exclusive", name="Network ACL Rules Exclusive")
+func newResourceNetworkACLRulesExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceNetworkACLRulesExclusive{}, nil
+}
+
+const (
+	ResNameNetworkACLRulesExclusive = "Network ACL Rules Exclusive"
+)
+
+type resourceNetworkACLRulesExclusive struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (r *resourceNetworkACLRulesExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ruleNumbersValidators := []validator.Set{
+		setvalidator.NoNullValues(),
+		setvalidator.ValueInt64sAre(int64validator.Between(1, 32766)),
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"egress_rule_numbers": schema.SetAttribute{
+				ElementType: types.Int64Type,
+				Required:    true,
+				Validators:  ruleNumbersValidators,
+			},
+			"ingress_rule_numbers": schema.SetAttribute{
+				ElementType: types.Int64Type,
+				Required:    true,
+				Validators:  ruleNumbersValidators,
+			},
+			"network_acl_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceNetworkACLRulesExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceNetworkACLRulesExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncRules(ctx, plan.NetworkACLID.ValueString(), flex.ExpandFrameworkInt32ValueSet(ctx, plan.IngressRuleNumbers), flex.ExpandFrameworkInt32ValueSet(ctx, plan.EgressRuleNumbers))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionCreating, ResNameNetworkACLRulesExclusive, plan.NetworkACLID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceNetworkACLRulesExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().EC2Client(ctx)
+
+	var state resourceNetworkACLRulesExclusiveData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ingress, egress, err := findNetworkACLRuleNumbersByID(ctx, conn, state.NetworkACLID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionReading, ResNameNetworkACLRulesExclusive, state.NetworkACLID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.EgressRuleNumbers = flattenNetworkACLRuleNumbers(egress)
+	state.IngressRuleNumbers = flattenNetworkACLRuleNumbers(ingress)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceNetworkACLRulesExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resourceNetworkACLRulesExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.IngressRuleNumbers.Equal(state.IngressRuleNumbers) || !plan.EgressRuleNumbers.Equal(state.EgressRuleNumbers) {
+		err := r.syncRules(ctx, plan.NetworkACLID.ValueString(), flex.ExpandFrameworkInt32ValueSet(ctx, plan.IngressRuleNumbers), flex.ExpandFrameworkInt32ValueSet(ctx, plan.EgressRuleNumbers))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.EC2, create.ErrActionUpdating, ResNameNetworkACLRulesExclusive, plan.NetworkACLID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncRules handles keeping the configured network ACL entries in sync
+// with the remote resource.
+//
+// Entries are managed by other resources, so this resource can only remove
+// them. Entries in the network ACL but not configured on this resource
+// will be deleted. Configured entries which do not exist in the network
+// ACL are reported as an error. The default deny entries are never
+// modified.
+func (r *resourceNetworkACLRulesExclusive) syncRules(ctx context.Context, naclID string, wantIngress, wantEgress []int32) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	haveIngress, haveEgress, err := findNetworkACLRuleNumbersByID(ctx, conn, naclID)
+	if err != nil {
+		return err
+	}
+
+	eq := func(n1, n2 int32) bool { return n1 == n2 }
+
+	missingIngress, removeIngress, _ := intflex.DiffSlices(haveIngress, wantIngress, eq)
+	if len(missingIngress) > 0 {
+		return fmt.Errorf("ingress rules %v not found in network ACL", missingIngress)
+	}
+
+	missingEgress, removeEgress, _ := intflex.DiffSlices(haveEgress, wantEgress, eq)
+	if len(missingEgress) > 0 {
+		return fmt.Errorf("egress rules %v not found in network ACL", missingEgress)
+	}
+
+	for _, ruleNumber := range removeIngress {
+		if err := deleteNetworkACLEntry(ctx, conn, naclID, false, ruleNumber); err != nil {
+			return err
+		}
+	}
+
+	for _, ruleNumber := range removeEgress {
+		if err := deleteNetworkACLEntry(ctx, conn, naclID, true, ruleNumber); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *resourceNetworkACLRulesExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("network_acl_id"), req, resp)
+}
+
+func deleteNetworkACLEntry(ctx context.Context, conn *ec2.Client, naclID string, egress bool, ruleNumber int32) error {
+	input := ec2.DeleteNetworkAclEntryInput{
+		Egress:       aws.Bool(egress),
+		NetworkAclId: aws.String(naclID),
+		RuleNumber:   aws.Int32(ruleNumber),
+	}
+	_, err := conn.DeleteNetworkAclEntry(ctx, &input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkACLEntryNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting EC2 Network ACL (%s) Entry (egress: %t)(%d): %w", naclID, egress, ruleNumber, err)
+	}
+
+	return nil
+}
+
+// findNetworkACLRuleNumbersByID returns the rule numbers of the ingress and egress entries in a network ACL,
+// excluding the default entries.
+func findNetworkACLRuleNumbersByID(ctx context.Context, conn *ec2.Client, id string) ([]int32, []int32, error) {
+	nacl, err := findNetworkACLByID(ctx, conn, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ingress, egress := make([]int32, 0), make([]int32, 0)
+	for _, entry := range nacl.Entries {
+		ruleNumber := aws.ToInt32(entry.RuleNumber)
+		if ruleNumber == defaultACLRuleNumberIPv4 || ruleNumber == defaultACLRuleNumberIPv6 {
+			continue
+		}
+
+		if aws.ToBool(entry.Egress) {
+			egress = append(egress, ruleNumber)
+		} else {
+			ingress = append(ingress, ruleNumber)
+		}
+	}
+
+	return ingress, egress, nil
+}
+
+// flattenNetworkACLRuleNumbers converts rule numbers to a framework Set value.
+// An empty slice is converted to an empty (non-null) Set.
+func flattenNetworkACLRuleNumbers(ruleNumbers []int32) types.Set {
+	elems := make([]attr.Value, len(ruleNumbers))
+
+	for i, v := range ruleNumbers {
+		elems[i] = types.Int64Value(int64(v))
+	}
+
+	return types.SetValueMust(types.Int64Type, elems)
+}
+
+type resourceNetworkACLRulesExclusiveData struct {
+	EgressRuleNumbers  types.Set    `tfsdk:"egress_rule_numbers"`
+	IngressRuleNumbers types.Set    `tfsdk:"ingress_rule_numbers"`
+	NetworkACLID       types.String `tfsdk:"network_acl_id"`
+}
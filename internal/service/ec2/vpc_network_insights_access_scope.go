@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_ec2_network_insights_access_scope", name="Network Insights Access Scope")
+// @Tags(identifierAttribute="id")
+// @Testing(tagsTest=false)
+func newNetworkInsightsAccessScopeResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &networkInsightsAccessScopeResource{}, nil
+}
+
+type networkInsightsAccessScopeResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[networkInsightsAccessScopeResourceModel]
+	framework.WithImportByID
+}
+
+func (r *networkInsightsAccessScopeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	matchPathBlock := accessScopePathBlock(ctx)
+	matchPathBlock.Validators = []validator.List{
+		listvalidator.IsRequired(),
+		listvalidator.SizeAtLeast(1),
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN:     framework.ARNAttributeComputedOnly(),
+			names.AttrID:      framework.IDAttribute(),
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"exclude_path": accessScopePathBlock(ctx),
+			"match_path":   matchPathBlock,
+		},
+	}
+}
+
+func accessScopePathBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[accessScopePathModel](ctx),
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplace(),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				names.AttrDestination: accessScopePathStatementBlock(ctx),
+				names.AttrSource:      accessScopePathStatementBlock(ctx),
+				"through_resource": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[throughResourcesStatementModel](ctx),
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"resource_statement": accessScopeResourceStatementBlock(ctx),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func accessScopePathStatementBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[pathStatementModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"packet_header_statement": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[packetHeaderStatementModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"destination_addresses": schema.SetAttribute{
+								CustomType:  fwtypes.SetOfStringType,
+								ElementType: types.StringType,
+								Optional:    true,
+							},
+							"destination_ports": schema.SetAttribute{
+								CustomType:  fwtypes.SetOfStringType,
+								ElementType: types.StringType,
+								Optional:    true,
+							},
+							"destination_prefix_lists": schema.SetAttribute{
+								CustomType:  fwtypes.SetOfStringType,
+								ElementType: types.StringType,
+								Optional:    true,
+							},
+							"protocols": schema.SetAttribute{
+								CustomType: fwtypes.SetOfStringEnumType[awstypes.Protocol](),
+								Optional:   true,
+							},
+							"source_addresses": schema.SetAttribute{
+								CustomType:  fwtypes.SetOfStringType,
+								ElementType: types.StringType,
+								Optional:    true,
+							},
+							"source_ports": schema.SetAttribute{
+								CustomType:  fwtypes.SetOfStringType,
+								ElementType: types.StringType,
+								Optional:    true,
+							},
+							"source_prefix_lists": schema.SetAttribute{
+								CustomType:  fwtypes.SetOfStringType,
+								ElementType: types.StringType,
+								Optional:    true,
+							},
+						},
+					},
+				},
+				"resource_statement": accessScopeResourceStatementBlock(ctx),
+			},
+		},
+	}
+}
+
+func accessScopeResourceStatementBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[resourceStatementModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"resource_types": schema.SetAttribute{
+					CustomType:  fwtypes.SetOfStringType,
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				names.AttrResources: schema.SetAttribute{
+					CustomType:  fwtypes.SetOfStringType,
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+func (r *networkInsightsAccessScopeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data networkInsightsAccessScopeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	var input ec2.CreateNetworkInsightsAccessScopeInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+	input.TagSpecifications = getTagSpecificationsIn(ctx, awstypes.ResourceTypeNetworkInsightsAccessScope)
+
+	output, err := conn.CreateNetworkInsightsAccessScope(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating EC2 Network Insights Access Scope", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	scope := output.NetworkInsightsAccessScope
+	data.NetworkInsightsAccessScopeARN = fwflex.StringToFramework(ctx, scope.NetworkInsightsAccessScopeArn)
+	data.NetworkInsightsAccessScopeID = fwflex.StringToFramework(ctx, scope.NetworkInsightsAccessScopeId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *networkInsightsAccessScopeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data networkInsightsAccessScopeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := data.NetworkInsightsAccessScopeID.ValueString()
+	scope, err := findNetworkInsightsAccessScopeByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Network Insights Access Scope (%s)", id), err.Error())
+
+		return
+	}
+
+	content, err := findNetworkInsightsAccessScopeContentByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Network Insights Access Scope (%s) content", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, scope, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, content, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, scope.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *networkInsightsAccessScopeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data networkInsightsAccessScopeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := data.NetworkInsightsAccessScopeID.ValueString()
+	input := ec2.DeleteNetworkInsightsAccessScopeInput{
+		NetworkInsightsAccessScopeId: aws.String(id),
+	}
+	_, err := conn.DeleteNetworkInsightsAccessScope(ctx, &input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsAccessScopeIdNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting EC2 Network Insights Access Scope (%s)", id), err.Error())
+
+		return
+	}
+}
+
+type networkInsightsAccessScopeResourceModel struct {
+	ExcludePath                   fwtypes.ListNestedObjectValueOf[accessScopePathModel] `tfsdk:"exclude_path"`
+	MatchPath                     fwtypes.ListNestedObjectValueOf[accessScopePathModel] `tfsdk:"match_path"`
+	NetworkInsightsAccessScopeARN types.String                                          `tfsdk:"arn"`
+	NetworkInsightsAccessScopeID  types.String                                          `tfsdk:"id"`
+	Tags                          tftags.Map                                            `tfsdk:"tags"`
+	TagsAll                       tftags.Map                                            `tfsdk:"tags_all"`
+}
+
+type accessScopePathModel struct {
+	Destination     fwtypes.ListNestedObjectValueOf[pathStatementModel]             `tfsdk:"destination"`
+	Source          fwtypes.ListNestedObjectValueOf[pathStatementModel]             `tfsdk:"source"`
+	ThroughResource fwtypes.ListNestedObjectValueOf[throughResourcesStatementModel] `tfsdk:"through_resource"`
+}
+
+type pathStatementModel struct {
+	PacketHeaderStatement fwtypes.ListNestedObjectValueOf[packetHeaderStatementModel] `tfsdk:"packet_header_statement"`
+	ResourceStatement     fwtypes.ListNestedObjectValueOf[resourceStatementModel]     `tfsdk:"resource_statement"`
+}
+
+type packetHeaderStatementModel struct {
+	DestinationAddresses   fwtypes.SetOfString                                       `tfsdk:"destination_addresses"`
+	DestinationPorts       fwtypes.SetOfString                                       `tfsdk:"destination_ports"`
+	DestinationPrefixLists fwtypes.SetOfString                                       `tfsdk:"destination_prefix_lists"`
+	Protocols              fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.Protocol]] `tfsdk:"protocols"`
+	SourceAddresses        fwtypes.SetOfString                                       `tfsdk:"source_addresses"`
+	SourcePorts            fwtypes.SetOfString                                       `tfsdk:"source_ports"`
+	SourcePrefixLists      fwtypes.SetOfString                                       `tfsdk:"source_prefix_lists"`
+}
+
+type resourceStatementModel struct {
+	ResourceTypes fwtypes.SetOfString `tfsdk:"resource_types"`
+	Resources     fwtypes.SetOfString `tfsdk:"resources"`
+}
+
+type throughResourcesStatementModel struct {
+	ResourceStatement fwtypes.ListNestedObjectValueOf[resourceStatementModel] `tfsdk:"resource_statement"`
+}
@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_ec2_network_insights_access_scope_analysis", name="Network Insights Access Scope Analysis")
+// @Tags(identifierAttribute="id")
+// @Testing(tagsTest=false)
+func newNetworkInsightsAccessScopeAnalysisResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &networkInsightsAccessScopeAnalysisResource{}
+
+	r.SetDefaultCreateTimeout(20 * time.Minute)
+
+	return r, nil
+}
+
+type networkInsightsAccessScopeAnalysisResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[networkInsightsAccessScopeAnalysisResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *networkInsightsAccessScopeAnalysisResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"analyzed_eni_count": schema.Int32Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"end_date": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"findings_found": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FindingsFound](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"network_insights_access_scope_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"start_date": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AnalysisStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatusMessage: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"warning_message": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *networkInsightsAccessScopeAnalysisResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data networkInsightsAccessScopeAnalysisResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	var input ec2.StartNetworkInsightsAccessScopeAnalysisInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+	input.TagSpecifications = getTagSpecificationsIn(ctx, awstypes.ResourceTypeNetworkInsightsAccessScopeAnalysis)
+
+	output, err := conn.StartNetworkInsightsAccessScopeAnalysis(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating EC2 Network Insights Access Scope Analysis", err.Error())
+
+		return
+	}
+
+	analysis := output.NetworkInsightsAccessScopeAnalysis
+	id := aws.ToString(analysis.NetworkInsightsAccessScopeAnalysisId)
+
+	if data.WaitForCompletion.ValueBool() {
+		analysis, err = waitNetworkInsightsAccessScopeAnalysisCreated(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts))
+
+		if err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 Network Insights Access Scope Analysis (%s) create", id), err.Error())
+
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, analysis, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *networkInsightsAccessScopeAnalysisResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data networkInsightsAccessScopeAnalysisResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := data.NetworkInsightsAccessScopeAnalysisID.ValueString()
+	analysis, err := findNetworkInsightsAccessScopeAnalysisByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Network Insights Access Scope Analysis (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, analysis, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, analysis.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *networkInsightsAccessScopeAnalysisResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data networkInsightsAccessScopeAnalysisResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	id := data.NetworkInsightsAccessScopeAnalysisID.ValueString()
+	input := ec2.DeleteNetworkInsightsAccessScopeAnalysisInput{
+		NetworkInsightsAccessScopeAnalysisId: aws.String(id),
+	}
+	_, err := conn.DeleteNetworkInsightsAccessScopeAnalysis(ctx, &input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsAccessScopeAnalysisIdNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting EC2 Network Insights Access Scope Analysis (%s)", id), err.Error())
+
+		return
+	}
+}
+
+type networkInsightsAccessScopeAnalysisResourceModel struct {
+	AnalyzedENICount                      types.Int32                                 `tfsdk:"analyzed_eni_count"`
+	EndDate                               timetypes.RFC3339                           `tfsdk:"end_date"`
+	FindingsFound                         fwtypes.StringEnum[awstypes.FindingsFound]  `tfsdk:"findings_found"`
+	NetworkInsightsAccessScopeAnalysisARN types.String                                `tfsdk:"arn"`
+	NetworkInsightsAccessScopeAnalysisID  types.String                                `tfsdk:"id"`
+	NetworkInsightsAccessScopeID          types.String                                `tfsdk:"network_insights_access_scope_id"`
+	StartDate                             timetypes.RFC3339                           `tfsdk:"start_date"`
+	Status                                fwtypes.StringEnum[awstypes.AnalysisStatus] `tfsdk:"status"`
+	StatusMessage                         types.String                                `tfsdk:"status_message"`
+	Tags                                  tftags.Map                                  `tfsdk:"tags"`
+	TagsAll                               tftags.Map                                  `tfsdk:"tags_all"`
+	Timeouts                              timeouts.Value                              `tfsdk:"timeouts"`
+	WaitForCompletion                     types.Bool                                  `tfsdk:"wait_for_completion"`
+	WarningMessage                        types.String                                `tfsdk:"warning_message"`
+}
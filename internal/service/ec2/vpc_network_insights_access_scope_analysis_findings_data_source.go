@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkDataSource("aws_ec2_network_insights_access_scope_analysis_findings", name="Network Insights Access Scope Analysis Findings")
+func newNetworkInsightsAccessScopeAnalysisFindingsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &networkInsightsAccessScopeAnalysisFindingsDataSource{}, nil
+}
+
+type networkInsightsAccessScopeAnalysisFindingsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *networkInsightsAccessScopeAnalysisFindingsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"analysis_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AnalysisStatus](),
+				Computed:   true,
+			},
+			"findings": framework.DataSourceComputedListOfObjectAttribute[accessScopeAnalysisFindingModel](ctx),
+			"findings_found": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FindingsFound](),
+				Computed:   true,
+			},
+			"network_insights_access_scope_analysis_id": schema.StringAttribute{
+				Required: true,
+			},
+			"network_insights_access_scope_id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *networkInsightsAccessScopeAnalysisFindingsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data networkInsightsAccessScopeAnalysisFindingsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().EC2Client(ctx)
+
+	id := data.NetworkInsightsAccessScopeAnalysisID.ValueString()
+	analysis, err := findNetworkInsightsAccessScopeAnalysisByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Network Insights Access Scope Analysis (%s)", id), tfresource.SingularDataSourceFindError("EC2 Network Insights Access Scope Analysis", err).Error())
+
+		return
+	}
+
+	findings, err := findNetworkInsightsAccessScopeAnalysisFindingsByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Network Insights Access Scope Analysis (%s) findings", id), err.Error())
+
+		return
+	}
+
+	data.AnalysisStatus = fwtypes.StringEnumValue(analysis.Status)
+	data.FindingsFound = fwtypes.StringEnumValue(analysis.FindingsFound)
+	data.NetworkInsightsAccessScopeID = fwflex.StringToFramework(ctx, analysis.NetworkInsightsAccessScopeId)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, findings, &data.Findings)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type networkInsightsAccessScopeAnalysisFindingsDataSourceModel struct {
+	AnalysisStatus                       fwtypes.StringEnum[awstypes.AnalysisStatus]                      `tfsdk:"analysis_status"`
+	Findings                             fwtypes.ListNestedObjectValueOf[accessScopeAnalysisFindingModel] `tfsdk:"findings"`
+	FindingsFound                        fwtypes.StringEnum[awstypes.FindingsFound]                       `tfsdk:"findings_found"`
+	NetworkInsightsAccessScopeAnalysisID types.String                                                     `tfsdk:"network_insights_access_scope_analysis_id"`
+	NetworkInsightsAccessScopeID         types.String                                                     `tfsdk:"network_insights_access_scope_id"`
+}
+
+type accessScopeAnalysisFindingModel struct {
+	FindingComponents fwtypes.ListNestedObjectValueOf[pathComponentModel] `tfsdk:"finding_components"`
+	FindingID         types.String                                        `tfsdk:"finding_id"`
+}
+
+type pathComponentModel struct {
+	AttachedTo                  fwtypes.ListNestedObjectValueOf[analysisComponentModel] `tfsdk:"attached_to"`
+	Component                   fwtypes.ListNestedObjectValueOf[analysisComponentModel] `tfsdk:"component"`
+	DestinationVPC              fwtypes.ListNestedObjectValueOf[analysisComponentModel] `tfsdk:"destination_vpc"`
+	ElasticLoadBalancerListener fwtypes.ListNestedObjectValueOf[analysisComponentModel] `tfsdk:"elastic_load_balancer_listener"`
+	SequenceNumber              types.Int32                                             `tfsdk:"sequence_number"`
+	ServiceName                 types.String                                            `tfsdk:"service_name"`
+	SourceVPC                   fwtypes.ListNestedObjectValueOf[analysisComponentModel] `tfsdk:"source_vpc"`
+	Subnet                      fwtypes.ListNestedObjectValueOf[analysisComponentModel] `tfsdk:"subnet"`
+	TransitGateway              fwtypes.ListNestedObjectValueOf[analysisComponentModel] `tfsdk:"transit_gateway"`
+	VPC                         fwtypes.ListNestedObjectValueOf[analysisComponentModel] `tfsdk:"vpc"`
+}
+
+type analysisComponentModel struct {
+	ARN  types.String `tfsdk:"arn"`
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
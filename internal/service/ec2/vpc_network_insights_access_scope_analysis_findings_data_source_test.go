@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCNetworkInsightsAccessScopeAnalysisFindingsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_access_scope_analysis.test"
+	dataSourceName := "data.aws_ec2_network_insights_access_scope_analysis_findings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAccessScopeAnalysisFindingsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "analysis_status", resourceName, names.AttrStatus),
+					resource.TestCheckResourceAttrSet(dataSourceName, "findings.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "findings_found", resourceName, "findings_found"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "network_insights_access_scope_analysis_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "network_insights_access_scope_id", resourceName, "network_insights_access_scope_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCNetworkInsightsAccessScopeAnalysisFindingsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInsightsAccessScopeAnalysisConfig_basic(rName), `
+data "aws_ec2_network_insights_access_scope_analysis_findings" "test" {
+  network_insights_access_scope_analysis_id = aws_ec2_network_insights_access_scope_analysis.test.id
+}
+`)
+}
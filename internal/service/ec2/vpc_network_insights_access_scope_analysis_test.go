@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCNetworkInsightsAccessScopeAnalysis_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_access_scope_analysis.test"
+	scopeResourceName := "aws_ec2_network_insights_access_scope.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsAccessScopeAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAccessScopeAnalysisConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAccessScopeAnalysisExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "ec2", regexache.MustCompile(`network-insights-access-scope-analysis/nisa-[0-9a-z]+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "end_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "findings_found"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_insights_access_scope_id", scopeResourceName, names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, "start_date"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "succeeded"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_completion"},
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkInsightsAccessScopeAnalysis_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_access_scope_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsAccessScopeAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAccessScopeAnalysisConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAccessScopeAnalysisExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceNetworkInsightsAccessScopeAnalysis, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkInsightsAccessScopeAnalysisExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindNetworkInsightsAccessScopeAnalysisByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckNetworkInsightsAccessScopeAnalysisDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_network_insights_access_scope_analysis" {
+				continue
+			}
+
+			_, err := tfec2.FindNetworkInsightsAccessScopeAnalysisByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Network Insights Access Scope Analysis %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCNetworkInsightsAccessScopeAnalysisConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInsightsAccessScopeConfig_basic(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_access_scope_analysis" "test" {
+  network_insights_access_scope_id = aws_ec2_network_insights_access_scope.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
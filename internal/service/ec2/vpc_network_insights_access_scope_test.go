@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCNetworkInsightsAccessScope_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_access_scope.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsAccessScopeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAccessScopeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAccessScopeExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "ec2", regexache.MustCompile(`network-insights-access-scope/nis-[0-9a-z]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "exclude_path.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "match_path.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "match_path.0.destination.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "match_path.0.destination.0.resource_statement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "match_path.0.destination.0.resource_statement.0.resource_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "match_path.0.destination.0.resource_statement.0.resource_types.*", "AWS::EC2::InternetGateway"),
+					resource.TestCheckResourceAttr(resourceName, "match_path.0.source.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkInsightsAccessScope_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_access_scope.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsAccessScopeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAccessScopeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAccessScopeExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceNetworkInsightsAccessScope, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkInsightsAccessScope_excludePath(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_access_scope.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsAccessScopeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAccessScopeConfig_excludePath(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAccessScopeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exclude_path.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "exclude_path.0.source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "exclude_path.0.source.0.packet_header_statement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "exclude_path.0.source.0.packet_header_statement.0.protocols.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "exclude_path.0.source.0.packet_header_statement.0.protocols.*", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "exclude_path.0.source.0.packet_header_statement.0.source_ports.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "exclude_path.0.source.0.packet_header_statement.0.source_ports.*", "443"),
+					resource.TestCheckResourceAttr(resourceName, "match_path.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkInsightsAccessScopeExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindNetworkInsightsAccessScopeByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckNetworkInsightsAccessScopeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_network_insights_access_scope" {
+				continue
+			}
+
+			_, err := tfec2.FindNetworkInsightsAccessScopeByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Network Insights Access Scope %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCNetworkInsightsAccessScopeConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_network_insights_access_scope" "test" {
+  match_path {
+    destination {
+      resource_statement {
+        resource_types = ["AWS::EC2::InternetGateway"]
+      }
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccVPCNetworkInsightsAccessScopeConfig_excludePath(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_network_insights_access_scope" "test" {
+  match_path {
+    destination {
+      resource_statement {
+        resource_types = ["AWS::EC2::InternetGateway"]
+      }
+    }
+  }
+
+  exclude_path {
+    source {
+      packet_header_statement {
+        protocols    = ["tcp"]
+        source_ports = ["443"]
+      }
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCRouteServerEndpoint_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_route_server_endpoint.test"
+	routeServerResourceName := "aws_vpc_route_server.test"
+	subnetResourceName := "aws_subnet.test.0"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rASN := sdkacctest.RandIntRange(64512, 65534)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCRouteServerEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteServerEndpointConfig_basic(rName, rASN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCRouteServerEndpointExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "ec2", regexache.MustCompile(`route-server-endpoint/rse-[0-9a-z]+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "eni_address"),
+					resource.TestCheckResourceAttrSet(resourceName, "eni_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "route_server_id", routeServerResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrSubnetID, subnetResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrVPCID, subnetResourceName, names.AttrVPCID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteServerEndpoint_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_route_server_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rASN := sdkacctest.RandIntRange(64512, 65534)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCRouteServerEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteServerEndpointConfig_basic(rName, rASN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCRouteServerEndpointExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceVPCRouteServerEndpoint, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVPCRouteServerEndpointDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_vpc_route_server_endpoint" {
+				continue
+			}
+
+			_, err := tfec2.FindRouteServerEndpointByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("VPC Route Server Endpoint %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckVPCRouteServerEndpointExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindRouteServerEndpointByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccVPCRouteServerEndpointConfig_basic(rName string, rASN int) string {
+	return acctest.ConfigCompose(testAccVPCRouteServerVPCAssociationConfig_basic(rName, rASN), fmt.Sprintf(`
+resource "aws_vpc_route_server_endpoint" "test" {
+  route_server_id = aws_vpc_route_server_vpc_association.test.route_server_id
+  subnet_id       = aws_subnet.test[0].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	vpcRouteServerPropagationIDParts = 2
+)
+
+// @FrameworkResource("aws_vpc_route_server_propagation", name="VPC Route Server Propagation")
+// @Testing(tagsTest=false)
+func newVPCRouteServerPropagationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &vpcRouteServerPropagationResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type vpcRouteServerPropagationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[vpcRouteServerPropagationResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *vpcRouteServerPropagationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"route_server_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"route_table_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RouteServerPropagationState](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *vpcRouteServerPropagationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data vpcRouteServerPropagationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	var input ec2.EnableRouteServerPropagationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	routeServerID, routeTableID := data.RouteServerID.ValueString(), data.RouteTableID.ValueString()
+	_, err := conn.EnableRouteServerPropagation(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating VPC Route Server (%s) Route Table (%s) Propagation", routeServerID, routeTableID), err.Error())
+
+		return
+	}
+
+	propagation, err := waitRouteServerPropagationCreated(ctx, conn, routeServerID, routeTableID, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for VPC Route Server (%s) Route Table (%s) Propagation create", routeServerID, routeTableID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.State = fwtypes.StringEnumValue(propagation.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *vpcRouteServerPropagationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data vpcRouteServerPropagationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	routeServerID, routeTableID := data.RouteServerID.ValueString(), data.RouteTableID.ValueString()
+	propagation, err := findRouteServerPropagationByTwoPartKey(ctx, conn, routeServerID, routeTableID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Route Server (%s) Route Table (%s) Propagation", routeServerID, routeTableID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, propagation, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *vpcRouteServerPropagationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data vpcRouteServerPropagationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	routeServerID, routeTableID := data.RouteServerID.ValueString(), data.RouteTableID.ValueString()
+	input := ec2.DisableRouteServerPropagationInput{
+		RouteServerId: aws.String(routeServerID),
+		RouteTableId:  aws.String(routeTableID),
+	}
+	_, err := conn.DisableRouteServerPropagation(ctx, &input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteServerIdNotFound, errCodeInvalidRouteTableIdNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting VPC Route Server (%s) Route Table (%s) Propagation", routeServerID, routeTableID), err.Error())
+
+		return
+	}
+
+	if _, err := waitRouteServerPropagationDeleted(ctx, conn, routeServerID, routeTableID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for VPC Route Server (%s) Route Table (%s) Propagation delete", routeServerID, routeTableID), err.Error())
+
+		return
+	}
+}
+
+func (r *vpcRouteServerPropagationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(request.ID, vpcRouteServerPropagationIDParts, false)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: route_server_id,route_table_id. Got: %q", request.ID),
+		)
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("route_server_id"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("route_table_id"), parts[1])...)
+}
+
+type vpcRouteServerPropagationResourceModel struct {
+	RouteServerID types.String                                             `tfsdk:"route_server_id"`
+	RouteTableID  types.String                                             `tfsdk:"route_table_id"`
+	State         fwtypes.StringEnum[awstypes.RouteServerPropagationState] `tfsdk:"state"`
+	Timeouts      timeouts.Value                                           `tfsdk:"timeouts"`
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCRouteServer_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_route_server.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rASN := sdkacctest.RandIntRange(64512, 65534)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCRouteServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteServerConfig_basic(rName, rASN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCRouteServerExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "ec2", regexache.MustCompile(`route-server/rs-[0-9a-z]+$`)),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("amazon_side_asn"), knownvalue.Int64Exact(int64(rASN))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("persist_routes"), knownvalue.StringExact("disable")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("sns_notifications_enabled"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						"Name": knownvalue.StringExact(rName),
+					})),
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteServer_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_route_server.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rASN := sdkacctest.RandIntRange(64512, 65534)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCRouteServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteServerConfig_basic(rName, rASN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCRouteServerExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceVPCRouteServer, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteServer_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_route_server.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rASN := sdkacctest.RandIntRange(64512, 65534)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCRouteServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteServerConfig_basic(rName, rASN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCRouteServerExists(ctx, resourceName),
+				),
+			},
+			{
+				Config: testAccVPCRouteServerConfig_persistRoutes(rName, rASN, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCRouteServerExists(ctx, resourceName),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("persist_routes"), knownvalue.StringExact("enable")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("persist_routes_duration"), knownvalue.Int64Exact(2)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("sns_notifications_enabled"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("sns_topic_arn"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: testAccVPCRouteServerConfig_basic(rName, rASN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCRouteServerExists(ctx, resourceName),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("persist_routes"), knownvalue.StringExact("disable")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("sns_notifications_enabled"), knownvalue.Bool(false)),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckVPCRouteServerDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_vpc_route_server" {
+				continue
+			}
+
+			_, err := tfec2.FindRouteServerByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("VPC Route Server %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckVPCRouteServerExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindRouteServerByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccVPCRouteServerConfig_basic(rName string, rASN int) string {
+	return fmt.Sprintf(`
+resource "aws_vpc_route_server" "test" {
+  amazon_side_asn = %[2]d
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, rASN)
+}
+
+func testAccVPCRouteServerConfig_persistRoutes(rName string, rASN, duration int) string {
+	return fmt.Sprintf(`
+resource "aws_vpc_route_server" "test" {
+  amazon_side_asn           = %[2]d
+  persist_routes            = "enable"
+  persist_routes_duration   = %[3]d
+  sns_notifications_enabled = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, rASN, duration)
+}
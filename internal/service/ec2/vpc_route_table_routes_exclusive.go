@@ -0,0 +1,520 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_route_table_routes_exclusive", name="Route Table Routes Exclusive")
+func newResourceRouteTableRoutesExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceRouteTableRoutesExclusive{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameRouteTableRoutesExclusive = "Route Table Routes Exclusive"
+)
+
+type resourceRouteTableRoutesExclusive struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (r *resourceRouteTableRoutesExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	destinations := []path.Expression{
+		path.MatchRelative().AtParent().AtName("destination_ipv6_cidr_block"),
+		path.MatchRelative().AtParent().AtName("destination_prefix_list_id"),
+	}
+	targets := []path.Expression{
+		path.MatchRelative().AtParent().AtName("carrier_gateway_id"),
+		path.MatchRelative().AtParent().AtName("core_network_arn"),
+		path.MatchRelative().AtParent().AtName("egress_only_gateway_id"),
+		path.MatchRelative().AtParent().AtName("local_gateway_id"),
+		path.MatchRelative().AtParent().AtName("nat_gateway_id"),
+		path.MatchRelative().AtParent().AtName(names.AttrNetworkInterfaceID),
+		path.MatchRelative().AtParent().AtName(names.AttrTransitGatewayID),
+		path.MatchRelative().AtParent().AtName(names.AttrVPCEndpointID),
+		path.MatchRelative().AtParent().AtName("vpc_peering_connection_id"),
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"route_table_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"route": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[routeTableRoutesExclusiveRouteModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"carrier_gateway_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"core_network_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+						"destination_cidr_block": schema.StringAttribute{
+							CustomType: fwtypes.CIDRBlockType,
+							Optional:   true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(destinations...),
+							},
+						},
+						"destination_ipv6_cidr_block": schema.StringAttribute{
+							CustomType: fwtypes.CIDRBlockType,
+							Optional:   true,
+						},
+						"destination_prefix_list_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"egress_only_gateway_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"gateway_id": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(targets...),
+							},
+						},
+						"local_gateway_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"nat_gateway_id": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrNetworkInterfaceID: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrTransitGatewayID: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrVPCEndpointID: schema.StringAttribute{
+							Optional: true,
+						},
+						"vpc_peering_connection_id": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceRouteTableRoutesExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceRouteTableRoutesExclusiveModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	want, diags := plan.Route.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncRoutes(ctx, plan.RouteTableID.ValueString(), tfslices.Values(want), r.CreateTimeout(ctx, plan.Timeouts))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionCreating, ResNameRouteTableRoutesExclusive, plan.RouteTableID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceRouteTableRoutesExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().EC2Client(ctx)
+
+	var state resourceRouteTableRoutesExclusiveModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routes, err := findRouteTableRoutesExclusiveRoutesByID(ctx, conn, state.RouteTableID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionReading, ResNameRouteTableRoutesExclusive, state.RouteTableID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	prior, diags := state.Route.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the prior representation of semantically equal CIDR destinations
+	// so that non-canonical IPv6 CIDR blocks don't produce a permanent diff.
+	for i, route := range routes {
+		for _, v := range prior {
+			if routeTableRoutesExclusiveRouteDestinationEqual(route, *v) {
+				routes[i].DestinationCIDRBlock = v.DestinationCIDRBlock
+				routes[i].DestinationIPv6CIDRBlock = v.DestinationIPv6CIDRBlock
+				break
+			}
+		}
+	}
+
+	route, diags := fwtypes.NewSetNestedObjectValueOfValueSlice(ctx, routes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Route = route
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceRouteTableRoutesExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resourceRouteTableRoutesExclusiveModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Route.Equal(state.Route) {
+		want, diags := plan.Route.ToSlice(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.syncRoutes(ctx, plan.RouteTableID.ValueString(), tfslices.Values(want), r.UpdateTimeout(ctx, plan.Timeouts))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.EC2, create.ErrActionUpdating, ResNameRouteTableRoutesExclusive, plan.RouteTableID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceRouteTableRoutesExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("route_table_id"), req, resp)
+}
+
+// syncRoutes handles keeping the configured routes in sync with the remote
+// route table.
+//
+// Routes in the route table but not configured on this resource are deleted,
+// configured routes missing from the route table are created, and routes
+// whose destination matches but whose target differs are replaced. The
+// local route, propagated routes and gateway VPC endpoint routes are never
+// modified.
+func (r *resourceRouteTableRoutesExclusive) syncRoutes(ctx context.Context, routeTableID string, want []routeTableRoutesExclusiveRouteModel, timeout time.Duration) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	have, err := findRouteTableRoutesExclusiveRoutesByID(ctx, conn, routeTableID)
+	if err != nil {
+		return err
+	}
+
+	add, remove, modify, _ := intflex.DiffSlicesWithModify(have, want, routeTableRoutesExclusiveRouteEqual, routeTableRoutesExclusiveRouteDestinationEqual)
+
+	for _, route := range remove {
+		if err := deleteRouteTableRoutesExclusiveRoute(ctx, conn, routeTableID, route, timeout); err != nil {
+			return err
+		}
+	}
+
+	for _, route := range add {
+		if err := createRouteTableRoutesExclusiveRoute(ctx, conn, routeTableID, route, timeout); err != nil {
+			return err
+		}
+	}
+
+	for _, route := range modify {
+		if err := replaceRouteTableRoutesExclusiveRoute(ctx, conn, routeTableID, route, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func createRouteTableRoutesExclusiveRoute(ctx context.Context, conn *ec2.Client, routeTableID string, route routeTableRoutesExclusiveRouteModel, timeout time.Duration) error {
+	routeFinder, destination := route.destination()
+	input := ec2.CreateRouteInput{
+		CarrierGatewayId:            route.CarrierGatewayID.ValueStringPointer(),
+		CoreNetworkArn:              route.CoreNetworkARN.ValueStringPointer(),
+		DestinationCidrBlock:        route.DestinationCIDRBlock.ValueStringPointer(),
+		DestinationIpv6CidrBlock:    route.DestinationIPv6CIDRBlock.ValueStringPointer(),
+		DestinationPrefixListId:     route.DestinationPrefixListID.ValueStringPointer(),
+		EgressOnlyInternetGatewayId: route.EgressOnlyGatewayID.ValueStringPointer(),
+		GatewayId:                   route.GatewayID.ValueStringPointer(),
+		LocalGatewayId:              route.LocalGatewayID.ValueStringPointer(),
+		NatGatewayId:                route.NATGatewayID.ValueStringPointer(),
+		NetworkInterfaceId:          route.NetworkInterfaceID.ValueStringPointer(),
+		RouteTableId:                aws.String(routeTableID),
+		TransitGatewayId:            route.TransitGatewayID.ValueStringPointer(),
+		VpcEndpointId:               route.VPCEndpointID.ValueStringPointer(),
+		VpcPeeringConnectionId:      route.VPCPeeringConnectionID.ValueStringPointer(),
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout,
+		func() (any, error) {
+			return conn.CreateRoute(ctx, &input)
+		},
+		errCodeInvalidParameterException,
+		errCodeInvalidTransitGatewayIDNotFound,
+	)
+
+	if err != nil {
+		return fmt.Errorf("creating Route in Route Table (%s) with destination (%s): %w", routeTableID, destination, err)
+	}
+
+	if _, err := waitRouteReady(ctx, conn, routeFinder, routeTableID, destination, timeout); err != nil {
+		return fmt.Errorf("waiting for Route in Route Table (%s) with destination (%s) create: %w", routeTableID, destination, err)
+	}
+
+	return nil
+}
+
+func replaceRouteTableRoutesExclusiveRoute(ctx context.Context, conn *ec2.Client, routeTableID string, route routeTableRoutesExclusiveRouteModel, timeout time.Duration) error {
+	routeFinder, destination := route.destination()
+	input := ec2.ReplaceRouteInput{
+		CarrierGatewayId:            route.CarrierGatewayID.ValueStringPointer(),
+		CoreNetworkArn:              route.CoreNetworkARN.ValueStringPointer(),
+		DestinationCidrBlock:        route.DestinationCIDRBlock.ValueStringPointer(),
+		DestinationIpv6CidrBlock:    route.DestinationIPv6CIDRBlock.ValueStringPointer(),
+		DestinationPrefixListId:     route.DestinationPrefixListID.ValueStringPointer(),
+		EgressOnlyInternetGatewayId: route.EgressOnlyGatewayID.ValueStringPointer(),
+		GatewayId:                   route.GatewayID.ValueStringPointer(),
+		LocalGatewayId:              route.LocalGatewayID.ValueStringPointer(),
+		NatGatewayId:                route.NATGatewayID.ValueStringPointer(),
+		NetworkInterfaceId:          route.NetworkInterfaceID.ValueStringPointer(),
+		RouteTableId:                aws.String(routeTableID),
+		TransitGatewayId:            route.TransitGatewayID.ValueStringPointer(),
+		VpcEndpointId:               route.VPCEndpointID.ValueStringPointer(),
+		VpcPeeringConnectionId:      route.VPCPeeringConnectionID.ValueStringPointer(),
+	}
+
+	_, err := conn.ReplaceRoute(ctx, &input)
+
+	if err != nil {
+		return fmt.Errorf("updating Route in Route Table (%s) with destination (%s): %w", routeTableID, destination, err)
+	}
+
+	if _, err := waitRouteReady(ctx, conn, routeFinder, routeTableID, destination, timeout); err != nil {
+		return fmt.Errorf("waiting for Route in Route Table (%s) with destination (%s) update: %w", routeTableID, destination, err)
+	}
+
+	return nil
+}
+
+func deleteRouteTableRoutesExclusiveRoute(ctx context.Context, conn *ec2.Client, routeTableID string, route routeTableRoutesExclusiveRouteModel, timeout time.Duration) error {
+	routeFinder, destination := route.destination()
+	input := ec2.DeleteRouteInput{
+		DestinationCidrBlock:     route.DestinationCIDRBlock.ValueStringPointer(),
+		DestinationIpv6CidrBlock: route.DestinationIPv6CIDRBlock.ValueStringPointer(),
+		DestinationPrefixListId:  route.DestinationPrefixListID.ValueStringPointer(),
+		RouteTableId:             aws.String(routeTableID),
+	}
+
+	_, err := conn.DeleteRoute(ctx, &input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting Route in Route Table (%s) with destination (%s): %w", routeTableID, destination, err)
+	}
+
+	if _, err := waitRouteDeleted(ctx, conn, routeFinder, routeTableID, destination, timeout); err != nil {
+		return fmt.Errorf("waiting for Route in Route Table (%s) with destination (%s) delete: %w", routeTableID, destination, err)
+	}
+
+	return nil
+}
+
+// findRouteTableRoutesExclusiveRoutesByID returns the routes in a route table which
+// are managed by this resource, excluding the local route, propagated routes and
+// gateway VPC endpoint routes.
+func findRouteTableRoutesExclusiveRoutesByID(ctx context.Context, conn *ec2.Client, id string) ([]routeTableRoutesExclusiveRouteModel, error) {
+	routeTable, err := findRouteTableByID(ctx, conn, id)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := make([]routeTableRoutesExclusiveRouteModel, 0)
+	for _, route := range routeTable.Routes {
+		if route.Origin != awstypes.RouteOriginCreateRoute {
+			continue
+		}
+
+		gatewayID := aws.ToString(route.GatewayId)
+		if gatewayID == gatewayIDLocal || gatewayID == gatewayIDVPCLattice {
+			continue
+		}
+
+		// Gateway VPC endpoint routes are managed by aws_vpc_endpoint_route_table_association.
+		if route.DestinationPrefixListId != nil && strings.HasPrefix(gatewayID, "vpce-") {
+			continue
+		}
+
+		routes = append(routes, flattenRouteTableRoutesExclusiveRoute(ctx, route))
+	}
+
+	return routes, nil
+}
+
+func flattenRouteTableRoutesExclusiveRoute(ctx context.Context, apiObject awstypes.Route) routeTableRoutesExclusiveRouteModel {
+	route := routeTableRoutesExclusiveRouteModel{
+		CarrierGatewayID:         flex.StringToFramework(ctx, apiObject.CarrierGatewayId),
+		CoreNetworkARN:           fwtypes.ARNNull(),
+		DestinationCIDRBlock:     fwtypes.CIDRBlockNull(),
+		DestinationIPv6CIDRBlock: fwtypes.CIDRBlockNull(),
+		DestinationPrefixListID:  flex.StringToFramework(ctx, apiObject.DestinationPrefixListId),
+		EgressOnlyGatewayID:      flex.StringToFramework(ctx, apiObject.EgressOnlyInternetGatewayId),
+		GatewayID:                types.StringNull(),
+		LocalGatewayID:           flex.StringToFramework(ctx, apiObject.LocalGatewayId),
+		NATGatewayID:             flex.StringToFramework(ctx, apiObject.NatGatewayId),
+		NetworkInterfaceID:       flex.StringToFramework(ctx, apiObject.NetworkInterfaceId),
+		TransitGatewayID:         flex.StringToFramework(ctx, apiObject.TransitGatewayId),
+		VPCEndpointID:            types.StringNull(),
+		VPCPeeringConnectionID:   flex.StringToFramework(ctx, apiObject.VpcPeeringConnectionId),
+	}
+
+	if v := apiObject.CoreNetworkArn; v != nil {
+		route.CoreNetworkARN = fwtypes.ARNValue(aws.ToString(v))
+	}
+
+	if v := apiObject.DestinationCidrBlock; v != nil {
+		route.DestinationCIDRBlock = fwtypes.CIDRBlockValue(aws.ToString(v))
+	}
+
+	if v := apiObject.DestinationIpv6CidrBlock; v != nil {
+		route.DestinationIPv6CIDRBlock = fwtypes.CIDRBlockValue(aws.ToString(v))
+	}
+
+	// Gateway Load Balancer endpoint routes are reported with the endpoint ID as the gateway ID.
+	if v := aws.ToString(apiObject.GatewayId); strings.HasPrefix(v, "vpce-") {
+		route.VPCEndpointID = types.StringValue(v)
+	} else if v != "" {
+		route.GatewayID = types.StringValue(v)
+	}
+
+	return route
+}
+
+func routeTableRoutesExclusiveRouteEqual(r1, r2 routeTableRoutesExclusiveRouteModel) bool {
+	return routeTableRoutesExclusiveRouteDestinationEqual(r1, r2) &&
+		r1.CarrierGatewayID.Equal(r2.CarrierGatewayID) &&
+		r1.CoreNetworkARN.Equal(r2.CoreNetworkARN) &&
+		r1.EgressOnlyGatewayID.Equal(r2.EgressOnlyGatewayID) &&
+		r1.GatewayID.Equal(r2.GatewayID) &&
+		r1.LocalGatewayID.Equal(r2.LocalGatewayID) &&
+		r1.NATGatewayID.Equal(r2.NATGatewayID) &&
+		r1.NetworkInterfaceID.Equal(r2.NetworkInterfaceID) &&
+		r1.TransitGatewayID.Equal(r2.TransitGatewayID) &&
+		r1.VPCEndpointID.Equal(r2.VPCEndpointID) &&
+		r1.VPCPeeringConnectionID.Equal(r2.VPCPeeringConnectionID)
+}
+
+func routeTableRoutesExclusiveRouteDestinationEqual(r1, r2 routeTableRoutesExclusiveRouteModel) bool {
+	return routeTableRoutesExclusiveCIDRBlockEqual(r1.DestinationCIDRBlock, r2.DestinationCIDRBlock) &&
+		routeTableRoutesExclusiveCIDRBlockEqual(r1.DestinationIPv6CIDRBlock, r2.DestinationIPv6CIDRBlock) &&
+		r1.DestinationPrefixListID.Equal(r2.DestinationPrefixListID)
+}
+
+// routeTableRoutesExclusiveCIDRBlockEqual compares CIDR blocks semantically,
+// as IPv6 CIDR blocks have multiple valid representations.
+func routeTableRoutesExclusiveCIDRBlockEqual(v1, v2 fwtypes.CIDRBlock) bool {
+	if v1.IsNull() || v1.IsUnknown() || v2.IsNull() || v2.IsUnknown() {
+		return v1.Equal(v2)
+	}
+
+	return itypes.CIDRBlocksEqual(v1.ValueString(), v2.ValueString())
+}
+
+type resourceRouteTableRoutesExclusiveModel struct {
+	Route        fwtypes.SetNestedObjectValueOf[routeTableRoutesExclusiveRouteModel] `tfsdk:"route"`
+	RouteTableID types.String                                                        `tfsdk:"route_table_id"`
+	Timeouts     timeouts.Value                                                      `tfsdk:"timeouts"`
+}
+
+type routeTableRoutesExclusiveRouteModel struct {
+	CarrierGatewayID         types.String      `tfsdk:"carrier_gateway_id"`
+	CoreNetworkARN           fwtypes.ARN       `tfsdk:"core_network_arn"`
+	DestinationCIDRBlock     fwtypes.CIDRBlock `tfsdk:"destination_cidr_block"`
+	DestinationIPv6CIDRBlock fwtypes.CIDRBlock `tfsdk:"destination_ipv6_cidr_block"`
+	DestinationPrefixListID  types.String      `tfsdk:"destination_prefix_list_id"`
+	EgressOnlyGatewayID      types.String      `tfsdk:"egress_only_gateway_id"`
+	GatewayID                types.String      `tfsdk:"gateway_id"`
+	LocalGatewayID           types.String      `tfsdk:"local_gateway_id"`
+	NATGatewayID             types.String      `tfsdk:"nat_gateway_id"`
+	NetworkInterfaceID       types.String      `tfsdk:"network_interface_id"`
+	TransitGatewayID         types.String      `tfsdk:"transit_gateway_id"`
+	VPCEndpointID            types.String      `tfsdk:"vpc_endpoint_id"`
+	VPCPeeringConnectionID   types.String      `tfsdk:"vpc_peering_connection_id"`
+}
+
+// destination returns the finder and value for the route's destination.
+func (m routeTableRoutesExclusiveRouteModel) destination() (routeFinder, string) {
+	switch {
+	case !m.DestinationCIDRBlock.IsNull():
+		return findRouteByIPv4Destination, m.DestinationCIDRBlock.ValueString()
+	case !m.DestinationIPv6CIDRBlock.IsNull():
+		return findRouteByIPv6Destination, m.DestinationIPv6CIDRBlock.ValueString()
+	default:
+		return findRouteByPrefixListIDDestination, m.DestinationPrefixListID.ValueString()
+	}
+}
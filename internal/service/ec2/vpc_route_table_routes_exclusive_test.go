@@ -0,0 +1,457 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCRouteTableRoutesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route_table_routes_exclusive.test"
+	routeTableResourceName := "aws_route_table.test"
+	igwResourceName := "aws_internet_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableRoutesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "route_table_id", routeTableResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"destination_cidr_block": "0.0.0.0/0",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "route.*.gateway_id", igwResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "route_table_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "route_table_id",
+				ImportStateVerifyIgnore:              []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteTableRoutesExclusive_disappears_RouteTable(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route_table_routes_exclusive.test"
+	routeTableResourceName := "aws_route_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableRoutesExclusiveExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceRouteTable(), routeTableResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteTableRoutesExclusive_multipleTargets(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route_table_routes_exclusive.test"
+	eniResourceName := "aws_network_interface.test"
+	igwResourceName := "aws_internet_gateway.test"
+	plResourceName := "aws_ec2_managed_prefix_list.test"
+	pcxResourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_multipleTargets(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableRoutesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "3"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "route.*.destination_prefix_list_id", plResourceName, names.AttrID),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "route.*.gateway_id", igwResourceName, names.AttrID),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "route.*.network_interface_id", eniResourceName, names.AttrID),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "route.*.vpc_peering_connection_id", pcxResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "route_table_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "route_table_id",
+				ImportStateVerifyIgnore:              []string{names.AttrTimeouts},
+			},
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableRoutesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "route.*.gateway_id", igwResourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteTableRoutesExclusive_replaceTarget(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route_table_routes_exclusive.test"
+	eniResourceName := "aws_network_interface.test"
+	igwResourceName := "aws_internet_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableRoutesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "route.*.gateway_id", igwResourceName, names.AttrID),
+				),
+			},
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_networkInterface(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableRoutesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"destination_cidr_block": "0.0.0.0/0",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "route.*.network_interface_id", eniResourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+// A route added out of band should be deleted
+func TestAccVPCRouteTableRoutesExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var routeTable awstypes.RouteTable
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route_table_routes_exclusive.test"
+	routeTableResourceName := "aws_route_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableExists(ctx, routeTableResourceName, &routeTable),
+					testAccCheckRouteTableRoutesExclusiveExists(ctx, resourceName),
+					testAccCheckRouteTableRoutesExclusiveCreateRoute(ctx, &routeTable, "10.10.0.0/16"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableRoutesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteTableRoutesExclusive_ipv6NonCanonical(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route_table_routes_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_ipv6(rName, "::0/0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableRoutesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"destination_ipv6_cidr_block": "::0/0",
+					}),
+				),
+			},
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_ipv6(rName, "::/0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableRoutesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"destination_ipv6_cidr_block": "::/0",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteTableRoutesExclusive_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route_table_routes_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoutesExclusiveConfig_empty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteTableRoutesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRouteTableRoutesExclusiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return create.Error(names.EC2, create.ErrActionCheckingExistence, tfec2.ResNameRouteTableRoutesExclusive, n, errors.New("not found"))
+		}
+
+		routeTableID := rs.Primary.Attributes["route_table_id"]
+		if routeTableID == "" {
+			return create.Error(names.EC2, create.ErrActionCheckingExistence, tfec2.ResNameRouteTableRoutesExclusive, n, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+		routes, err := tfec2.FindRouteTableRoutesExclusiveRoutesByID(ctx, conn, routeTableID)
+		if err != nil {
+			return create.Error(names.EC2, create.ErrActionCheckingExistence, tfec2.ResNameRouteTableRoutesExclusive, routeTableID, err)
+		}
+
+		if v := rs.Primary.Attributes["route.#"]; v != strconv.Itoa(len(routes)) {
+			return create.Error(names.EC2, create.ErrActionCheckingExistence, tfec2.ResNameRouteTableRoutesExclusive, routeTableID, errors.New("unexpected route count"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRouteTableRoutesExclusiveCreateRoute(ctx context.Context, routeTable *awstypes.RouteTable, destination string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		var gatewayID *string
+		for _, route := range routeTable.Routes {
+			if v := aws.ToString(route.GatewayId); v != "" && v != "local" {
+				gatewayID = route.GatewayId
+			}
+		}
+
+		input := ec2.CreateRouteInput{
+			DestinationCidrBlock: aws.String(destination),
+			GatewayId:            gatewayID,
+			RouteTableId:         routeTable.RouteTableId,
+		}
+		_, err := conn.CreateRoute(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccVPCRouteTableRoutesExclusiveConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface" "test" {
+  subnet_id = aws_subnet.test[0].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccVPCRouteTableRoutesExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCRouteTableRoutesExclusiveConfig_base(rName), `
+resource "aws_route_table_routes_exclusive" "test" {
+  route_table_id = aws_route_table.test.id
+
+  route {
+    destination_cidr_block = "0.0.0.0/0"
+    gateway_id             = aws_internet_gateway.test.id
+  }
+}
+`)
+}
+
+func testAccVPCRouteTableRoutesExclusiveConfig_networkInterface(rName string) string {
+	return acctest.ConfigCompose(testAccVPCRouteTableRoutesExclusiveConfig_base(rName), `
+resource "aws_route_table_routes_exclusive" "test" {
+  route_table_id = aws_route_table.test.id
+
+  route {
+    destination_cidr_block = "0.0.0.0/0"
+    network_interface_id   = aws_network_interface.test.id
+  }
+}
+`)
+}
+
+func testAccVPCRouteTableRoutesExclusiveConfig_ipv6(rName, destination string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block                       = "10.1.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_egress_only_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table_routes_exclusive" "test" {
+  route_table_id = aws_route_table.test.id
+
+  route {
+    destination_ipv6_cidr_block = %[2]q
+    egress_only_gateway_id      = aws_egress_only_internet_gateway.test.id
+  }
+}
+`, rName, destination)
+}
+
+func testAccVPCRouteTableRoutesExclusiveConfig_multipleTargets(rName string) string {
+	return acctest.ConfigCompose(testAccVPCRouteTableRoutesExclusiveConfig_base(rName), fmt.Sprintf(`
+resource "aws_vpc" "peer" {
+  cidr_block = "10.2.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.test.id
+  peer_vpc_id = aws_vpc.peer.id
+  auto_accept = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_managed_prefix_list" "test" {
+  address_family = "IPv4"
+  max_entries    = 1
+  name           = %[1]q
+
+  entry {
+    cidr = "172.16.0.0/16"
+  }
+}
+
+resource "aws_route_table_routes_exclusive" "test" {
+  route_table_id = aws_route_table.test.id
+
+  route {
+    destination_cidr_block = "0.0.0.0/0"
+    gateway_id             = aws_internet_gateway.test.id
+  }
+
+  route {
+    destination_cidr_block    = aws_vpc.peer.cidr_block
+    vpc_peering_connection_id = aws_vpc_peering_connection.test.id
+  }
+
+  route {
+    destination_prefix_list_id = aws_ec2_managed_prefix_list.test.id
+    network_interface_id       = aws_network_interface.test.id
+  }
+}
+`, rName))
+}
+
+func testAccVPCRouteTableRoutesExclusiveConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccVPCRouteTableRoutesExclusiveConfig_base(rName), `
+resource "aws_route_table_routes_exclusive" "test" {
+  route_table_id = aws_route_table.test.id
+}
+`)
+}
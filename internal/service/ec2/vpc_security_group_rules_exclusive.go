@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_vpc_security_group_rules_exclusive", name="Security Group Rules Exclusive")
+func newResourceSecurityGroupRulesExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceSecurityGroupRulesExclusive{}, nil
+}
+
+const (
+	ResNameSecurityGroupRulesExclusive = "Security Group Rules Exclusive"
+)
+
+type resourceSecurityGroupRulesExclusive struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"egress_rule_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"ingress_rule_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceSecurityGroupRulesExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncRules(ctx, plan.SecurityGroupID.ValueString(), flex.ExpandFrameworkStringValueSet(ctx, plan.IngressRuleIDs), flex.ExpandFrameworkStringValueSet(ctx, plan.EgressRuleIDs))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionCreating, ResNameSecurityGroupRulesExclusive, plan.SecurityGroupID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().EC2Client(ctx)
+
+	var state resourceSecurityGroupRulesExclusiveData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ingress, egress, err := findSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, state.SecurityGroupID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionReading, ResNameSecurityGroupRulesExclusive, state.SecurityGroupID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.EgressRuleIDs = flex.FlattenFrameworkStringValueSetLegacy(ctx, egress)
+	state.IngressRuleIDs = flex.FlattenFrameworkStringValueSetLegacy(ctx, ingress)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceSecurityGroupRulesExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resourceSecurityGroupRulesExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.IngressRuleIDs.Equal(state.IngressRuleIDs) || !plan.EgressRuleIDs.Equal(state.EgressRuleIDs) {
+		err := r.syncRules(ctx, plan.SecurityGroupID.ValueString(), flex.ExpandFrameworkStringValueSet(ctx, plan.IngressRuleIDs), flex.ExpandFrameworkStringValueSet(ctx, plan.EgressRuleIDs))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.EC2, create.ErrActionUpdating, ResNameSecurityGroupRulesExclusive, plan.SecurityGroupID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncRules handles keeping the configured security group rules in sync
+// with the remote resource.
+//
+// Rules are managed by other resources, so this resource can only remove
+// them. Rules in the security group but not configured on this resource
+// will be revoked. Configured rules which do not exist in the security
+// group are reported as an error.
+func (r *resourceSecurityGroupRulesExclusive) syncRules(ctx context.Context, groupID string, wantIngress, wantEgress []string) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	haveIngress, haveEgress, err := findSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, groupID)
+	if err != nil {
+		return err
+	}
+
+	eq := func(s1, s2 string) bool { return s1 == s2 }
+
+	missingIngress, removeIngress, _ := intflex.DiffSlices(haveIngress, wantIngress, eq)
+	if len(missingIngress) > 0 {
+		return fmt.Errorf("ingress rules %v not found in security group", missingIngress)
+	}
+
+	missingEgress, removeEgress, _ := intflex.DiffSlices(haveEgress, wantEgress, eq)
+	if len(missingEgress) > 0 {
+		return fmt.Errorf("egress rules %v not found in security group", missingEgress)
+	}
+
+	if len(removeIngress) > 0 {
+		input := ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: removeIngress,
+		}
+		_, err := conn.RevokeSecurityGroupIngress(ctx, &input)
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, errCodeInvalidSecurityGroupRuleIdNotFound) {
+			return fmt.Errorf("revoking ingress rules %v: %w", removeIngress, err)
+		}
+	}
+
+	if len(removeEgress) > 0 {
+		input := ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: removeEgress,
+		}
+		_, err := conn.RevokeSecurityGroupEgress(ctx, &input)
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, errCodeInvalidSecurityGroupRuleIdNotFound) {
+			return fmt.Errorf("revoking egress rules %v: %w", removeEgress, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *resourceSecurityGroupRulesExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("security_group_id"), req, resp)
+}
+
+// findSecurityGroupRuleIDsBySecurityGroupID returns the IDs of the ingress and egress rules in a security group.
+func findSecurityGroupRuleIDsBySecurityGroupID(ctx context.Context, conn *ec2.Client, id string) ([]string, []string, error) {
+	// Rules are listed by filter, which returns no results rather than an error for a missing group.
+	if _, err := findSecurityGroupByID(ctx, conn, id); err != nil {
+		return nil, nil, err
+	}
+
+	rules, err := findSecurityGroupRulesBySecurityGroupID(ctx, conn, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ingress, egress := make([]string, 0), make([]string, 0)
+	for _, rule := range rules {
+		if aws.ToBool(rule.IsEgress) {
+			egress = append(egress, aws.ToString(rule.SecurityGroupRuleId))
+		} else {
+			ingress = append(ingress, aws.ToString(rule.SecurityGroupRuleId))
+		}
+	}
+
+	return ingress, egress, nil
+}
+
+type resourceSecurityGroupRulesExclusiveData struct {
+	EgressRuleIDs   types.Set    `tfsdk:"egress_rule_ids"`
+	IngressRuleIDs  types.Set    `tfsdk:"ingress_rule_ids"`
+	SecurityGroupID types.String `tfsdk:"security_group_id"`
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameAuthorizationToken = "Ephemeral Resource Authorization Token"
+)
+
+// @EphemeralResource(aws_ecr_authorization_token, name="Authorization Token")
+func newEphemeralAuthorizationToken(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAuthorizationToken{}, nil
+}
+
+type ephemeralAuthorizationToken struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralAuthorizationToken) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authorization_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expires_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrPassword: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"proxy_endpoint": schema.StringAttribute{
+				Computed: true,
+			},
+			"registry_id": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrUserName: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralAuthorizationToken) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().ECRClient(ctx)
+	data := epAuthorizationTokenData{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := ecr.GetAuthorizationTokenInput{}
+	if v := fwflex.StringValueFromFramework(ctx, data.RegistryID); v != "" {
+		input.RegistryIds = []string{v}
+	}
+
+	output, err := conn.GetAuthorizationToken(ctx, &input)
+
+	if err == nil && (output == nil || len(output.AuthorizationData) == 0) {
+		err = errors.New("empty result")
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ECR, create.ErrActionOpening, ERNameAuthorizationToken, data.RegistryID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	authorizationData := output.AuthorizationData[0]
+	authorizationToken := aws.ToString(authorizationData.AuthorizationToken)
+
+	authBytes, err := itypes.Base64Decode(authorizationToken)
+	if err != nil {
+		response.Diagnostics.AddError("decoding ECR authorization token", err.Error())
+		return
+	}
+	userName, password, ok := strings.Cut(string(authBytes), ":")
+	if !ok {
+		response.Diagnostics.AddError("decoding ECR authorization token", "unknown ECR authorization token format")
+		return
+	}
+
+	data.AuthorizationToken = types.StringValue(authorizationToken)
+	data.ExpiresAt = timetypes.NewRFC3339TimeValue(aws.ToTime(authorizationData.ExpiresAt).UTC().Truncate(time.Second))
+	data.Password = types.StringValue(password)
+	data.ProxyEndpoint = fwflex.StringToFramework(ctx, authorizationData.ProxyEndpoint)
+	data.UserName = types.StringValue(userName)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epAuthorizationTokenData struct {
+	AuthorizationToken types.String      `tfsdk:"authorization_token"`
+	ExpiresAt          timetypes.RFC3339 `tfsdk:"expires_at"`
+	Password           types.String      `tfsdk:"password"`
+	ProxyEndpoint      types.String      `tfsdk:"proxy_endpoint"`
+	RegistryID         types.String      `tfsdk:"registry_id"`
+	UserName           types.String      `tfsdk:"user_name"`
+}
@@ -28,23 +28,27 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceAccessPoint,
 			TypeName: "aws_efs_access_point",
 			Name:     "Access Point",
+			Region:   types.ResourceRegionDefault(),
 			Tags:     &types.ServicePackageResourceTags{},
 		},
 		{
 			Factory:  dataSourceAccessPoints,
 			TypeName: "aws_efs_access_points",
 			Name:     "Access Point",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceFileSystem,
 			TypeName: "aws_efs_file_system",
 			Name:     "File System",
+			Region:   types.ResourceRegionDefault(),
 			Tags:     &types.ServicePackageResourceTags{},
 		},
 		{
 			Factory:  dataSourceMountTarget,
 			TypeName: "aws_efs_mount_target",
 			Name:     "Mount Target",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -55,6 +59,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceAccessPoint,
 			TypeName: "aws_efs_access_point",
 			Name:     "Access Point",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
@@ -63,11 +68,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceBackupPolicy,
 			TypeName: "aws_efs_backup_policy",
 			Name:     "Backup Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceFileSystem,
 			TypeName: "aws_efs_file_system",
 			Name:     "File System",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
@@ -76,16 +83,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceFileSystemPolicy,
 			TypeName: "aws_efs_file_system_policy",
 			Name:     "File System Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceMountTarget,
 			TypeName: "aws_efs_mount_target",
 			Name:     "Mount Target",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceReplicationConfiguration,
 			TypeName: "aws_efs_replication_configuration",
 			Name:     "Replication Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -99,6 +99,7 @@ var hostedZoneIDPerRegionNLBMap = map[string]string{
 }
 
 // @SDKDataSource("aws_lb_hosted_zone_id", name="Hosted Zone ID")
+// @Region(overrideEnabled=false)
 func dataSourceHostedZoneID() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceHostedZoneIDRead,
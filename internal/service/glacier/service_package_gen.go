@@ -32,6 +32,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceVault,
 			TypeName: "aws_glacier_vault",
 			Name:     "Vault",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
@@ -40,6 +41,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceVaultLock,
 			TypeName: "aws_glacier_vault_lock",
 			Name:     "Vault Lock",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
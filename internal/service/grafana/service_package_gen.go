@@ -24,11 +24,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newWorkspaceServiceAccountResource,
 			TypeName: "aws_grafana_workspace_service_account",
 			Name:     "Workspace Service Account",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newWorkspaceServiceAccountTokenResource,
 			TypeName: "aws_grafana_workspace_service_account_token",
 			Name:     "Workspace Service Account Token",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -39,6 +41,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceWorkspace,
 			TypeName: "aws_grafana_workspace",
 			Name:     "Workspace",
+			Region:   types.ResourceRegionDefault(),
 			Tags:     &types.ServicePackageResourceTags{},
 		},
 	}
@@ -50,16 +53,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceLicenseAssociation,
 			TypeName: "aws_grafana_license_association",
 			Name:     "License Association",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceRoleAssociation,
 			TypeName: "aws_grafana_role_association",
 			Name:     "Workspace Role Association",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceWorkspace,
 			TypeName: "aws_grafana_workspace",
 			Name:     "Workspace",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -68,11 +74,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceWorkspaceAPIKey,
 			TypeName: "aws_grafana_workspace_api_key",
 			Name:     "Workspace API Key",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceWorkspaceSAMLConfiguration,
 			TypeName: "aws_grafana_workspace_saml_configuration",
 			Name:     "Grafana Workspace SAML Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
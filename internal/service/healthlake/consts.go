@@ -0,0 +1,10 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+const (
+	ResNameFHIRDatastore = "FHIR Datastore"
+
+	DSNameFHIRDatastore = "FHIR Datastore Data Source"
+)
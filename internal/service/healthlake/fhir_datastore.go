@@ -0,0 +1,348 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_healthlake_fhir_datastore", name="FHIR Datastore")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/healthlake/types;awstypes;awstypes.DatastoreProperties")
+func newFHIRDatastoreResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &fhirDatastoreResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type fhirDatastoreResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpUpdate[fhirDatastoreResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *fhirDatastoreResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"datastore_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"datastore_type_version": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FHIRVersion](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"identity_provider_configuration": framework.ResourceOptionalComputedListOfObjectsAttribute[identityProviderConfigurationModel](ctx, 1, nil, listplanmodifier.RequiresReplaceIfConfigured(), listplanmodifier.UseStateForUnknown()),
+			names.AttrName: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"preload_data_config": framework.ResourceOptionalListOfObjectsAttribute[preloadDataConfigModel](ctx, 1, nil, listplanmodifier.RequiresReplace()),
+			"sse_configuration":   framework.ResourceOptionalComputedListOfObjectsAttribute[sseConfigurationModel](ctx, 1, nil, listplanmodifier.RequiresReplaceIfConfigured(), listplanmodifier.UseStateForUnknown()),
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DatastoreStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+var fhirDatastoreFlexOpt = fwflex.WithFieldNamePrefix("Datastore")
+
+func (r *fhirDatastoreResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data fhirDatastoreResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	var input healthlake.CreateFHIRDatastoreInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, fhirDatastoreFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateFHIRDatastore(ctx, &input)
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.HealthLake, create.ErrActionCreating, ResNameFHIRDatastore, data.Name.ValueString(), err)
+
+		return
+	}
+
+	id := aws.ToString(output.DatastoreId)
+	data.ID = types.StringValue(id)
+
+	datastore, err := waitFHIRDatastoreCreated(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+		create.AddError(&response.Diagnostics, names.HealthLake, create.ErrActionWaitingForCreation, ResNameFHIRDatastore, id, err)
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, datastore, &data, fhirDatastoreFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *fhirDatastoreResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data fhirDatastoreResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	output, err := findFHIRDatastoreByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.HealthLake, create.ErrActionReading, ResNameFHIRDatastore, data.ID.ValueString(), err)
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fhirDatastoreFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *fhirDatastoreResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data fhirDatastoreResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	input := &healthlake.DeleteFHIRDatastoreInput{
+		DatastoreId: data.ID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteFHIRDatastore(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.HealthLake, create.ErrActionDeleting, ResNameFHIRDatastore, data.ID.ValueString(), err)
+
+		return
+	}
+
+	if _, err := waitFHIRDatastoreDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		create.AddError(&response.Diagnostics, names.HealthLake, create.ErrActionWaitingForDeletion, ResNameFHIRDatastore, data.ID.ValueString(), err)
+
+		return
+	}
+}
+
+func findFHIRDatastoreByID(ctx context.Context, conn *healthlake.Client, id string) (*awstypes.DatastoreProperties, error) {
+	input := &healthlake.DescribeFHIRDatastoreInput{
+		DatastoreId: aws.String(id),
+	}
+
+	output, err := conn.DescribeFHIRDatastore(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DatastoreProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.DatastoreProperties.DatastoreStatus; status == awstypes.DatastoreStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.DatastoreProperties, nil
+}
+
+func statusFHIRDatastore(ctx context.Context, conn *healthlake.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findFHIRDatastoreByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.DatastoreStatus), nil
+	}
+}
+
+func waitFHIRDatastoreCreated(ctx context.Context, conn *healthlake.Client, id string, timeout time.Duration) (*awstypes.DatastoreProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DatastoreStatusCreating),
+		Target:     enum.Slice(awstypes.DatastoreStatusActive),
+		Refresh:    statusFHIRDatastore(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DatastoreProperties); ok {
+		if v := output.ErrorCause; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(v.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFHIRDatastoreDeleted(ctx context.Context, conn *healthlake.Client, id string, timeout time.Duration) (*awstypes.DatastoreProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DatastoreStatusActive, awstypes.DatastoreStatusDeleting),
+		Target:     []string{},
+		Refresh:    statusFHIRDatastore(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DatastoreProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type fhirDatastoreResourceModel struct {
+	ARN                           types.String                                                        `tfsdk:"arn"`
+	CreatedAt                     timetypes.RFC3339                                                   `tfsdk:"created_at"`
+	DatastoreEndpoint             types.String                                                        `tfsdk:"datastore_endpoint"`
+	DatastoreTypeVersion          fwtypes.StringEnum[awstypes.FHIRVersion]                            `tfsdk:"datastore_type_version"`
+	ID                            types.String                                                        `tfsdk:"id"`
+	IdentityProviderConfiguration fwtypes.ListNestedObjectValueOf[identityProviderConfigurationModel] `tfsdk:"identity_provider_configuration"`
+	Name                          types.String                                                        `tfsdk:"name"`
+	PreloadDataConfig             fwtypes.ListNestedObjectValueOf[preloadDataConfigModel]             `tfsdk:"preload_data_config"`
+	SSEConfiguration              fwtypes.ListNestedObjectValueOf[sseConfigurationModel]              `tfsdk:"sse_configuration"`
+	Status                        fwtypes.StringEnum[awstypes.DatastoreStatus]                        `tfsdk:"status"`
+	Tags                          tftags.Map                                                          `tfsdk:"tags"`
+	TagsAll                       tftags.Map                                                          `tfsdk:"tags_all"`
+	Timeouts                      timeouts.Value                                                      `tfsdk:"timeouts"`
+}
+
+type identityProviderConfigurationModel struct {
+	AuthorizationStrategy           fwtypes.StringEnum[awstypes.AuthorizationStrategy] `tfsdk:"authorization_strategy"`
+	FineGrainedAuthorizationEnabled types.Bool                                         `tfsdk:"fine_grained_authorization_enabled"`
+	IdpLambdaARN                    fwtypes.ARN                                        `tfsdk:"idp_lambda_arn"`
+	Metadata                        types.String                                       `tfsdk:"metadata"`
+}
+
+type preloadDataConfigModel struct {
+	PreloadDataType fwtypes.StringEnum[awstypes.PreloadDataType] `tfsdk:"preload_data_type"`
+}
+
+type sseConfigurationModel struct {
+	KMSEncryptionConfig fwtypes.ListNestedObjectValueOf[kmsEncryptionConfigModel] `tfsdk:"kms_encryption_config"`
+}
+
+type kmsEncryptionConfigModel struct {
+	CMKType  fwtypes.StringEnum[awstypes.CmkType] `tfsdk:"cmk_type"`
+	KMSKeyID types.String                         `tfsdk:"kms_key_id"`
+}
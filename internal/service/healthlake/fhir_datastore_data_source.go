@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+import (
+	"context"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_healthlake_fhir_datastore", name="FHIR Datastore")
+// @Tags(identifierAttribute="arn")
+func newFHIRDatastoreDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &fhirDatastoreDataSource{}, nil
+}
+
+type fhirDatastoreDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *fhirDatastoreDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"datastore_endpoint": schema.StringAttribute{
+				Computed: true,
+			},
+			"datastore_type_version": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FHIRVersion](),
+				Computed:   true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Required: true,
+			},
+			"identity_provider_configuration": framework.DataSourceComputedListOfObjectAttribute[identityProviderConfigurationModel](ctx),
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			"preload_data_config": framework.DataSourceComputedListOfObjectAttribute[preloadDataConfigModel](ctx),
+			"sse_configuration":   framework.DataSourceComputedListOfObjectAttribute[sseConfigurationModel](ctx),
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DatastoreStatus](),
+				Computed:   true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *fhirDatastoreDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data fhirDatastoreDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().HealthLakeClient(ctx)
+
+	output, err := findFHIRDatastoreByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.HealthLake, create.ErrActionReading, DSNameFHIRDatastore, data.ID.ValueString(), err), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fhirDatastoreFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type fhirDatastoreDataSourceModel struct {
+	ARN                           types.String                                                        `tfsdk:"arn"`
+	CreatedAt                     timetypes.RFC3339                                                   `tfsdk:"created_at"`
+	DatastoreEndpoint             types.String                                                        `tfsdk:"datastore_endpoint"`
+	DatastoreTypeVersion          fwtypes.StringEnum[awstypes.FHIRVersion]                            `tfsdk:"datastore_type_version"`
+	ID                            types.String                                                        `tfsdk:"id"`
+	IdentityProviderConfiguration fwtypes.ListNestedObjectValueOf[identityProviderConfigurationModel] `tfsdk:"identity_provider_configuration"`
+	Name                          types.String                                                        `tfsdk:"name"`
+	PreloadDataConfig             fwtypes.ListNestedObjectValueOf[preloadDataConfigModel]             `tfsdk:"preload_data_config"`
+	SSEConfiguration              fwtypes.ListNestedObjectValueOf[sseConfigurationModel]              `tfsdk:"sse_configuration"`
+	Status                        fwtypes.StringEnum[awstypes.DatastoreStatus]                        `tfsdk:"status"`
+	Tags                          tftags.Map                                                          `tfsdk:"tags"`
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_healthlake_fhir_datastore", sweepFHIRDatastores)
+}
+
+func sweepFHIRDatastores(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	input := healthlake.ListFHIRDatastoresInput{
+		Filter: &awstypes.DatastoreFilter{
+			DatastoreStatus: awstypes.DatastoreStatusActive,
+		},
+	}
+	conn := client.HealthLakeClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := healthlake.NewListFHIRDatastoresPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.DatastorePropertiesList {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newFHIRDatastoreResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.DatastoreId))))
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -0,0 +1,27 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+# tflint-ignore: terraform_unused_declarations
+data "aws_healthlake_fhir_datastore" "test" {
+  id = aws_healthlake_fhir_datastore.test.id
+}
+
+resource "aws_healthlake_fhir_datastore" "test" {
+  name                   = var.rName
+  datastore_type_version = "R4"
+
+  tags = var.resource_tags
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "resource_tags" {
+  description = "Tags to set on resource. To specify no tags, set to `null`"
+  # Not setting a default, so that this must explicitly be set to `null` to specify no tags
+  type     = map(string)
+  nullable = true
+}
@@ -1,7 +1,7 @@
 // Copyright (c) HashiCorp, Inc.
 // SPDX-License-Identifier: MPL-2.0
 
-//go:generate go run ../../generate/listpages/main.go -Paginator=Marker -ListOps=ListGroupsForUser
+//go:generate go run ../../generate/listpages/main.go -Paginator=Marker -ListOps=ListGroupsForUser,ListRoles
 //go:generate go run ../../generate/tags/main.go -ServiceTagsSlice -KeyValueTagsFunc=KeyValueTags
 //go:generate go run ../../generate/servicepackage/main.go
 //go:generate go run ../../generate/tagstests/main.go
@@ -391,6 +391,60 @@ func TestIAMPolicyStatementConditionSet_MarshalJSON(t *testing.T) { // nosemgrep
 	}
 }
 
+func TestIAMPolicyStatementConditionSet_UnmarshalJSON(t *testing.T) { // nosemgrep:ci.iam-in-func-name
+	t.Parallel()
+
+	testcases := map[string]struct {
+		data    []byte
+		want    tfiam.IAMPolicyStatementConditionSet
+		wantErr bool
+	}{
+		"string list": {
+			data: []byte(`{"StringLike":{"s3:prefix":["one/","two/"]}}`),
+			want: tfiam.IAMPolicyStatementConditionSet{
+				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"one/", "two/"}},
+			},
+		},
+		"bool": {
+			data: []byte(`{"Bool":{"aws:SecureTransport":false}}`),
+			want: tfiam.IAMPolicyStatementConditionSet{
+				{Test: "Bool", Variable: "aws:SecureTransport", Values: "false"},
+			},
+		},
+		"bool list": {
+			data: []byte(`{"Bool":{"aws:SecureTransport":[false]}}`),
+			want: tfiam.IAMPolicyStatementConditionSet{
+				{Test: "Bool", Variable: "aws:SecureTransport", Values: []string{"false"}},
+			},
+		},
+		"number list": {
+			data: []byte(`{"NumericLessThan":{"s3:max-keys":[10,1.5]}}`),
+			want: tfiam.IAMPolicyStatementConditionSet{
+				{Test: "NumericLessThan", Variable: "s3:max-keys", Values: []string{"10", "1.5"}},
+			},
+		},
+		"invalid list element type": {
+			data:    []byte(`{"StringLike":{"s3:prefix":[{"one":"two"}]}}`),
+			wantErr: true,
+		},
+	}
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got tfiam.IAMPolicyStatementConditionSet
+			err := got.UnmarshalJSON(tc.data)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("IAMPolicyStatementConditionSet.UnmarshalJSON() error = %v, wantErr %v", err, tc.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("IAMPolicyStatementConditionSet.UnmarshalJSON() = %#v, want %#v", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestPolicyUnmarshalServicePrincipalOrder(t *testing.T) {
 	t.Parallel()
 
@@ -22,6 +22,7 @@ import (
 
 func TestAccIAMPolicy_basic(t *testing.T) {
 	ctx := acctest.Context(t)
+	acctest.EmulatorCompatible(t)
 	var out awstypes.Policy
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_iam_policy.test"
@@ -124,6 +125,7 @@ func TestAccIAMPolicy_whitespace(t *testing.T) {
 
 func TestAccIAMPolicy_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
+	acctest.EmulatorCompatible(t)
 	var out awstypes.Policy
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_iam_policy.test"
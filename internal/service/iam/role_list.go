@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_iam_role", name="Role")
+func newRoleResourceAsListResource() itypes.ListResourceForSDK {
+	return &roleListResource{}
+}
+
+type roleListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type roleListResourceModel struct {
+	PathPrefix types.String `tfsdk:"path_prefix"`
+}
+
+func (l *roleListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"path_prefix": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Path prefix for filtering the results.",
+			},
+		},
+	}
+}
+
+func (l *roleListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data roleListResourceModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.IAMClient(ctx)
+
+	input := iam.ListRolesInput{
+		PathPrefix: fwflex.StringFromFramework(ctx, data.PathPrefix),
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		err := listRolesPages(ctx, conn, &input, func(page *iam.ListRolesOutput, lastPage bool) bool {
+			for _, v := range page.Roles {
+				name := aws.ToString(v.RoleName)
+
+				result := request.NewListResult(ctx)
+				rd := l.ResourceData()
+				rd.SetId(name)
+				if err := rd.Set(names.AttrName, name); err != nil {
+					result.Diagnostics.AddError("Setting "+names.AttrName, err.Error())
+					yield(result)
+					return false
+				}
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+				if !result.Diagnostics.HasError() && rd.Id() == "" {
+					continue
+				}
+				result.DisplayName = name
+
+				if !yield(result) {
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			var result list.ListResult
+			result.Diagnostics.AddError("Listing IAM Roles", fmt.Sprintf("listing IAM Roles: %s", err))
+			yield(result)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIAMRole_List_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleListConfig_basic(rName),
+			},
+			{
+				Query:  true,
+				Config: testAccRoleListQuery_basic(rName, false),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("aws_iam_role.test", 1),
+					querycheck.ExpectIdentity("aws_iam_role.test", map[string]knownvalue.Check{
+						names.AttrAccountID: knownvalue.StringExact(acctest.AccountID(ctx)),
+						names.AttrName:      knownvalue.StringExact(rName),
+					}),
+					querycheck.ExpectResourceDisplayName("aws_iam_role.test", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						names.AttrAccountID: knownvalue.StringExact(acctest.AccountID(ctx)),
+						names.AttrName:      knownvalue.StringExact(rName),
+					}), knownvalue.StringExact(rName)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccIAMRole_List_includeResource(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleListConfig_basic(rName),
+			},
+			{
+				Query:  true,
+				Config: testAccRoleListQuery_basic(rName, true),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("aws_iam_role.test", 1),
+					querycheck.ExpectResourceKnownValues("aws_iam_role.test", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						names.AttrAccountID: knownvalue.StringExact(acctest.AccountID(ctx)),
+						names.AttrName:      knownvalue.StringExact(rName),
+					}), []querycheck.KnownValueCheck{
+						{Path: tfjsonpath.New(names.AttrName), KnownValue: knownvalue.StringExact(rName)},
+						{Path: tfjsonpath.New(names.AttrPath), KnownValue: knownvalue.StringExact(fmt.Sprintf("/%s/", rName))},
+						{Path: tfjsonpath.New(names.AttrARN), KnownValue: knownvalue.NotNull()},
+						{Path: tfjsonpath.New("assume_role_policy"), KnownValue: knownvalue.NotNull()},
+					}),
+				},
+			},
+		},
+	})
+}
+
+func testAccRoleListConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+  path = "/%[1]s/"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole",
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}",
+      }
+      Effect = "Allow"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccRoleListQuery_basic(rName string, includeResource bool) string {
+	return fmt.Sprintf(`
+provider "aws" {}
+
+list "aws_iam_role" "test" {
+  provider         = aws
+  include_resource = %[2]t
+
+  config {
+    path_prefix = "/%[1]s/"
+  }
+}
+`, rName, includeResource)
+}
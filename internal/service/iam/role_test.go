@@ -26,6 +26,7 @@ import (
 
 func TestAccIAMRole_basic(t *testing.T) {
 	ctx := acctest.Context(t)
+	acctest.EmulatorCompatible(t)
 	var conf awstypes.Role
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_iam_role.test"
@@ -393,6 +394,7 @@ func TestAccIAMRole_badJSON(t *testing.T) {
 
 func TestAccIAMRole_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
+	acctest.EmulatorCompatible(t)
 	var role awstypes.Role
 
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
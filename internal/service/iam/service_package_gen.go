@@ -152,6 +152,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 	}
 }
 
+func (p *servicePackage) SDKListResources(ctx context.Context) []*types.ServicePackageSDKListResource {
+	return []*types.ServicePackageSDKListResource{
+		{
+			Factory:  newRoleResourceAsListResource,
+			TypeName: "aws_iam_role",
+			Name:     "Role",
+		},
+	}
+}
+
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
 		{
@@ -225,6 +235,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceRole,
 			TypeName: "aws_iam_role",
 			Name:     "Role",
+			Identity: types.GlobalSingleParameterIdentity(names.AttrName),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrName,
 				ResourceType:        "Role",
@@ -25,6 +25,7 @@ import (
 
 func TestAccIAMUser_basic(t *testing.T) {
 	ctx := acctest.Context(t)
+	acctest.EmulatorCompatible(t)
 	var conf awstypes.User
 
 	name1 := fmt.Sprintf("test-user-%d", sdkacctest.RandInt())
@@ -66,6 +67,7 @@ func TestAccIAMUser_basic(t *testing.T) {
 
 func TestAccIAMUser_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
+	acctest.EmulatorCompatible(t)
 	var user awstypes.User
 
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -24,6 +24,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceBillingGroup,
 			TypeName: "aws_iot_billing_group",
 			Name:     "Billing Group",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -37,11 +38,13 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceEndpoint,
 			TypeName: "aws_iot_endpoint",
 			Name:     "Endpoint",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceRegistrationCode,
 			TypeName: "aws_iot_registration_code",
 			Name:     "Registration Code",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -52,6 +55,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceAuthorizer,
 			TypeName: "aws_iot_authorizer",
 			Name:     "Authorizer",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -60,6 +64,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceCACertificate,
 			TypeName: "aws_iot_ca_certificate",
 			Name:     "CA Certificate",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -68,11 +73,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceCertificate,
 			TypeName: "aws_iot_certificate",
 			Name:     "Certificate",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceDomainConfiguration,
 			TypeName: "aws_iot_domain_configuration",
 			Name:     "Domain Configuration",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -81,21 +88,25 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceEventConfigurations,
 			TypeName: "aws_iot_event_configurations",
 			Name:     "Event Configurations",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceIndexingConfiguration,
 			TypeName: "aws_iot_indexing_configuration",
 			Name:     "Indexing Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceLoggingOptions,
 			TypeName: "aws_iot_logging_options",
 			Name:     "Logging Options",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourcePolicy,
 			TypeName: "aws_iot_policy",
 			Name:     "Policy",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -104,11 +115,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourcePolicyAttachment,
 			TypeName: "aws_iot_policy_attachment",
 			Name:     "Policy Attachment",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceProvisioningTemplate,
 			TypeName: "aws_iot_provisioning_template",
 			Name:     "Provisioning Template",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -117,6 +130,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceRoleAlias,
 			TypeName: "aws_iot_role_alias",
 			Name:     "Role Alias",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -125,11 +139,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceThing,
 			TypeName: "aws_iot_thing",
 			Name:     "Thing",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceThingGroup,
 			TypeName: "aws_iot_thing_group",
 			Name:     "Thing Group",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -138,16 +154,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceThingGroupMembership,
 			TypeName: "aws_iot_thing_group_membership",
 			Name:     "Thing Group Membership",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceThingPrincipalAttachment,
 			TypeName: "aws_iot_thing_principal_attachment",
 			Name:     "Thing Principal Attachment",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceThingType,
 			TypeName: "aws_iot_thing_type",
 			Name:     "Thing Type",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -156,6 +175,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceTopicRule,
 			TypeName: "aws_iot_topic_rule",
 			Name:     "Topic Rule",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -164,6 +184,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceTopicRuleDestination,
 			TypeName: "aws_iot_topic_rule_destination",
 			Name:     "Topic Rule Destination",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -20,6 +20,7 @@ func (p *servicePackage) EphemeralResources(ctx context.Context) []*types.Servic
 			Factory:  newEphemeralSecrets,
 			TypeName: "aws_kms_secrets",
 			Name:     "Secrets",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -38,36 +39,43 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceAlias,
 			TypeName: "aws_kms_alias",
 			Name:     "Alias",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceCiphertext,
 			TypeName: "aws_kms_ciphertext",
 			Name:     "Ciphertext",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceCustomKeyStore,
 			TypeName: "aws_kms_custom_key_store",
 			Name:     "Custom Key Store",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceKey,
 			TypeName: "aws_kms_key",
 			Name:     "Key",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourcePublicKey,
 			TypeName: "aws_kms_public_key",
 			Name:     "Public Key",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceSecret,
 			TypeName: "aws_kms_secret",
 			Name:     "Secret",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceSecrets,
 			TypeName: "aws_kms_secrets",
 			Name:     "Secrets",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -78,21 +86,25 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceAlias,
 			TypeName: "aws_kms_alias",
 			Name:     "Alias",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceCiphertext,
 			TypeName: "aws_kms_ciphertext",
 			Name:     "Ciphertext",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceCustomKeyStore,
 			TypeName: "aws_kms_custom_key_store",
 			Name:     "Custom Key Store",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceExternalKey,
 			TypeName: "aws_kms_external_key",
 			Name:     "External Key",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
@@ -101,11 +113,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceGrant,
 			TypeName: "aws_kms_grant",
 			Name:     "Grant",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceKey,
 			TypeName: "aws_kms_key",
 			Name:     "Key",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
@@ -114,11 +128,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceKeyPolicy,
 			TypeName: "aws_kms_key_policy",
 			Name:     "Key Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceReplicaExternalKey,
 			TypeName: "aws_kms_replica_external_key",
 			Name:     "Replica External Key",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
@@ -127,6 +143,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceReplicaKey,
 			TypeName: "aws_kms_replica_key",
 			Name:     "Replica Key",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
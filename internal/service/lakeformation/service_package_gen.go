@@ -24,16 +24,19 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceDataCellsFilter,
 			TypeName: "aws_lakeformation_data_cells_filter",
 			Name:     "Data Cells Filter",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceOptIn,
 			TypeName: "aws_lakeformation_opt_in",
 			Name:     "Opt In",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceResourceLFTag,
 			TypeName: "aws_lakeformation_resource_lf_tag",
 			Name:     "Resource LF Tag",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -44,16 +47,19 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceDataLakeSettings,
 			TypeName: "aws_lakeformation_data_lake_settings",
 			Name:     "Data Lake Settings",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  DataSourcePermissions,
 			TypeName: "aws_lakeformation_permissions",
 			Name:     "Permissions",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  DataSourceResource,
 			TypeName: "aws_lakeformation_resource",
 			Name:     "Resource",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -64,26 +70,31 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceDataLakeSettings,
 			TypeName: "aws_lakeformation_data_lake_settings",
 			Name:     "Data Lake Settings",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceLFTag,
 			TypeName: "aws_lakeformation_lf_tag",
 			Name:     "LF Tag",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourcePermissions,
 			TypeName: "aws_lakeformation_permissions",
 			Name:     "Permissions",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceResource,
 			TypeName: "aws_lakeformation_resource",
 			Name:     "Resource",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceResourceLFTags,
 			TypeName: "aws_lakeformation_resource_lf_tags",
 			Name:     "Resource LF Tags",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
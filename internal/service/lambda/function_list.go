@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @SDKListResource("aws_lambda_function", name="Function")
+func newFunctionResourceAsListResource() itypes.ListResourceForSDK {
+	return &functionListResource{}
+}
+
+type functionListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *functionListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{}
+}
+
+func (l *functionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.LambdaClient(ctx)
+
+	var input lambda.ListFunctionsInput
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		pages := lambda.NewListFunctionsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				var result list.ListResult
+				result.Diagnostics.AddError("Listing Lambda Functions", fmt.Sprintf("listing Lambda Functions: %s", err))
+				yield(result)
+				return
+			}
+
+			for _, v := range page.Functions {
+				name := aws.ToString(v.FunctionName)
+
+				result := request.NewListResult(ctx)
+				rd := l.ResourceData()
+				rd.SetId(name)
+				if err := rd.Set("function_name", name); err != nil {
+					result.Diagnostics.AddError("Setting function_name", err.Error())
+					yield(result)
+					return
+				}
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+				if !result.Diagnostics.HasError() && rd.Id() == "" {
+					continue
+				}
+				result.DisplayName = name
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaFunction_List_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_list_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_list_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_list_%s", rString)
+	sgName := fmt.Sprintf("tf_acc_sg_lambda_func_list_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_basic(funcName, policyName, roleName, sgName),
+			},
+			{
+				Query:  true,
+				Config: testAccFunctionListQuery_basic(false),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("aws_lambda_function.test", 1),
+					querycheck.ExpectIdentity("aws_lambda_function.test", map[string]knownvalue.Check{
+						names.AttrAccountID: knownvalue.StringExact(acctest.AccountID(ctx)),
+						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
+						"function_name":     knownvalue.StringExact(funcName),
+					}),
+					querycheck.ExpectResourceDisplayName("aws_lambda_function.test", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						names.AttrAccountID: knownvalue.StringExact(acctest.AccountID(ctx)),
+						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
+						"function_name":     knownvalue.StringExact(funcName),
+					}), knownvalue.StringExact(funcName)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunction_List_includeResource(t *testing.T) {
+	ctx := acctest.Context(t)
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_list_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_list_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_list_%s", rString)
+	sgName := fmt.Sprintf("tf_acc_sg_lambda_func_list_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_basic(funcName, policyName, roleName, sgName),
+			},
+			{
+				Query:  true,
+				Config: testAccFunctionListQuery_basic(true),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("aws_lambda_function.test", 1),
+					querycheck.ExpectResourceKnownValues("aws_lambda_function.test", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						names.AttrAccountID: knownvalue.StringExact(acctest.AccountID(ctx)),
+						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
+						"function_name":     knownvalue.StringExact(funcName),
+					}), []querycheck.KnownValueCheck{
+						{Path: tfjsonpath.New("function_name"), KnownValue: knownvalue.StringExact(funcName)},
+						{Path: tfjsonpath.New("handler"), KnownValue: knownvalue.StringExact("exports.example")},
+						{Path: tfjsonpath.New("runtime"), KnownValue: knownvalue.StringExact("nodejs20.x")},
+						{Path: tfjsonpath.New(names.AttrARN), KnownValue: knownvalue.NotNull()},
+					}),
+				},
+			},
+		},
+	})
+}
+
+func testAccFunctionListQuery_basic(includeResource bool) string {
+	return fmt.Sprintf(`
+provider "aws" {}
+
+list "aws_lambda_function" "test" {
+  provider         = aws
+  include_resource = %[1]t
+}
+`, includeResource)
+}
@@ -42,6 +42,7 @@ func testAccErrorCheckSkip(t *testing.T) resource.ErrorCheckFunc {
 
 func TestAccLambdaFunction_basic(t *testing.T) {
 	ctx := acctest.Context(t)
+	acctest.EmulatorCompatible(t)
 	var conf lambda.GetFunctionOutput
 	resourceName := "aws_lambda_function.test"
 
@@ -94,6 +95,7 @@ func TestAccLambdaFunction_basic(t *testing.T) {
 
 func TestAccLambdaFunction_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
+	acctest.EmulatorCompatible(t)
 	var function lambda.GetFunctionOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_lambda_function.test"
@@ -20,6 +20,7 @@ func (p *servicePackage) EphemeralResources(ctx context.Context) []*types.Servic
 			Factory:  newEphemeralInvocation,
 			TypeName: "aws_lambda_invocation",
 			Name:     "Invocation",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -34,11 +35,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceFunctionRecursionConfig,
 			TypeName: "aws_lambda_function_recursion_config",
 			Name:     "Function Recursion Config",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceRuntimeManagementConfig,
 			TypeName: "aws_lambda_runtime_management_config",
 			Name:     "Runtime Management Config",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -49,37 +52,55 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceAlias,
 			TypeName: "aws_lambda_alias",
 			Name:     "Alias",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceCodeSigningConfig,
 			TypeName: "aws_lambda_code_signing_config",
 			Name:     "Code Signing Config",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceFunction,
 			TypeName: "aws_lambda_function",
 			Name:     "Function",
+			Region:   types.ResourceRegionDefault(),
 			Tags:     &types.ServicePackageResourceTags{},
 		},
 		{
 			Factory:  dataSourceFunctionURL,
 			TypeName: "aws_lambda_function_url",
 			Name:     "Function URL",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceFunctions,
 			TypeName: "aws_lambda_functions",
 			Name:     "Functions",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceInvocation,
 			TypeName: "aws_lambda_invocation",
 			Name:     "Invocation",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceLayerVersion,
 			TypeName: "aws_lambda_layer_version",
 			Name:     "Layer Version",
+			Region:   types.ResourceRegionDefault(),
+		},
+	}
+}
+
+func (p *servicePackage) SDKListResources(ctx context.Context) []*types.ServicePackageSDKListResource {
+	return []*types.ServicePackageSDKListResource{
+		{
+			Factory:  newFunctionResourceAsListResource,
+			TypeName: "aws_lambda_function",
+			Name:     "Function",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -90,11 +111,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceAlias,
 			TypeName: "aws_lambda_alias",
 			Name:     "Alias",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceCodeSigningConfig,
 			TypeName: "aws_lambda_code_signing_config",
 			Name:     "Code Signing Config",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -103,6 +126,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceEventSourceMapping,
 			TypeName: "aws_lambda_event_source_mapping",
 			Name:     "Event Source Mapping",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -111,6 +135,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceFunction,
 			TypeName: "aws_lambda_function",
 			Name:     "Function",
+			Region:   types.ResourceRegionDefault(),
+			Identity: types.RegionalSingleParameterIdentity("function_name"),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -119,36 +145,43 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceFunctionEventInvokeConfig,
 			TypeName: "aws_lambda_function_event_invoke_config",
 			Name:     "Function Event Invoke Config",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceFunctionURL,
 			TypeName: "aws_lambda_function_url",
 			Name:     "Function URL",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceInvocation,
 			TypeName: "aws_lambda_invocation",
 			Name:     "Invocation",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceLayerVersion,
 			TypeName: "aws_lambda_layer_version",
 			Name:     "Layer Version",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceLayerVersionPermission,
 			TypeName: "aws_lambda_layer_version_permission",
 			Name:     "Layer Version Permission",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourcePermission,
 			TypeName: "aws_lambda_permission",
 			Name:     "Permission",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceProvisionedConcurrencyConfig,
 			TypeName: "aws_lambda_provisioned_concurrency_config",
 			Name:     "Provisioned Concurrency Config",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -28,21 +28,25 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceBot,
 			TypeName: "aws_lex_bot",
 			Name:     "Bot",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceBotAlias,
 			TypeName: "aws_lex_bot_alias",
 			Name:     "Bot Alias",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceIntent,
 			TypeName: "aws_lex_intent",
 			Name:     "Intent",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceSlotType,
 			TypeName: "aws_lex_slot_type",
 			Name:     "Slot Type",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -53,21 +57,25 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceBot,
 			TypeName: "aws_lex_bot",
 			Name:     "Bot",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBotAlias,
 			TypeName: "aws_lex_bot_alias",
 			Name:     "Bot Alias",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceIntent,
 			TypeName: "aws_lex_intent",
 			Name:     "Intent",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceSlotType,
 			TypeName: "aws_lex_slot_type",
 			Name:     "Slot Type",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
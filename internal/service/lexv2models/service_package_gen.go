@@ -24,6 +24,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceBot,
 			TypeName: "aws_lexv2models_bot",
 			Name:     "Bot",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -32,26 +33,31 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceBotLocale,
 			TypeName: "aws_lexv2models_bot_locale",
 			Name:     "Bot Locale",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceBotVersion,
 			TypeName: "aws_lexv2models_bot_version",
 			Name:     "Bot Version",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceIntent,
 			TypeName: "aws_lexv2models_intent",
 			Name:     "Intent",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceSlot,
 			TypeName: "aws_lexv2models_slot",
 			Name:     "Slot",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceSlotType,
 			TypeName: "aws_lexv2models_slot_type",
 			Name:     "Slot Type",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
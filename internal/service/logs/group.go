@@ -26,6 +26,7 @@ import (
 )
 
 // @SDKResource("aws_cloudwatch_log_group", name="Log Group")
+// @IdentityAttribute("name")
 // @Tags(identifierAttribute="arn")
 // @Testing(destroyTakesT=true)
 // @Testing(existsTakesT=true)
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_cloudwatch_log_group", name="Log Group")
+func newGroupResourceAsListResource() itypes.ListResourceForSDK {
+	return &groupListResource{}
+}
+
+type groupListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type groupListResourceModel struct {
+	LogGroupNamePrefix types.String `tfsdk:"log_group_name_prefix"`
+}
+
+func (l *groupListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"log_group_name_prefix": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Log group name prefix for filtering the results.",
+			},
+		},
+	}
+}
+
+func (l *groupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data groupListResourceModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.LogsClient(ctx)
+
+	input := cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: fwflex.StringFromFramework(ctx, data.LogGroupNamePrefix),
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		err := describeLogGroupsPages(ctx, conn, &input, func(page *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) bool {
+			for _, v := range page.LogGroups {
+				name := aws.ToString(v.LogGroupName)
+
+				result := request.NewListResult(ctx)
+				rd := l.ResourceData()
+				rd.SetId(name)
+				if err := rd.Set(names.AttrName, name); err != nil {
+					result.Diagnostics.AddError("Setting "+names.AttrName, err.Error())
+					yield(result)
+					return false
+				}
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+				if !result.Diagnostics.HasError() && rd.Id() == "" {
+					continue
+				}
+				result.DisplayName = name
+
+				if !yield(result) {
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			var result list.ListResult
+			result.Diagnostics.AddError("Listing CloudWatch Logs Log Groups", fmt.Sprintf("listing CloudWatch Logs Log Groups: %s", err))
+			yield(result)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLogsGroup_List_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLogGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_basic(rName),
+			},
+			{
+				Query:  true,
+				Config: testAccGroupListQuery_basic(rName, false),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("aws_cloudwatch_log_group.test", 1),
+					querycheck.ExpectIdentity("aws_cloudwatch_log_group.test", map[string]knownvalue.Check{
+						names.AttrAccountID: knownvalue.StringExact(acctest.AccountID(ctx)),
+						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
+						names.AttrName:      knownvalue.StringExact(rName),
+					}),
+					querycheck.ExpectResourceDisplayName("aws_cloudwatch_log_group.test", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						names.AttrAccountID: knownvalue.StringExact(acctest.AccountID(ctx)),
+						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
+						names.AttrName:      knownvalue.StringExact(rName),
+					}), knownvalue.StringExact(rName)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccLogsGroup_List_includeResource(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLogGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_basic(rName),
+			},
+			{
+				Query:  true,
+				Config: testAccGroupListQuery_basic(rName, true),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("aws_cloudwatch_log_group.test", 1),
+					querycheck.ExpectResourceKnownValues("aws_cloudwatch_log_group.test", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						names.AttrAccountID: knownvalue.StringExact(acctest.AccountID(ctx)),
+						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
+						names.AttrName:      knownvalue.StringExact(rName),
+					}), []querycheck.KnownValueCheck{
+						{Path: tfjsonpath.New(names.AttrName), KnownValue: knownvalue.StringExact(rName)},
+						{Path: tfjsonpath.New(names.AttrRegion), KnownValue: knownvalue.StringExact(acctest.Region())},
+						{Path: tfjsonpath.New(names.AttrARN), KnownValue: knownvalue.NotNull()},
+						{Path: tfjsonpath.New("retention_in_days"), KnownValue: knownvalue.Int64Exact(0)},
+					}),
+				},
+			},
+		},
+	})
+}
+
+func testAccGroupListQuery_basic(rName string, includeResource bool) string {
+	return fmt.Sprintf(`
+provider "aws" {}
+
+list "aws_cloudwatch_log_group" "test" {
+  provider         = aws
+  include_resource = %[2]t
+
+  config {
+    log_group_name_prefix = %[1]q
+  }
+}
+`, rName, includeResource)
+}
@@ -24,6 +24,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newApplicationResource,
 			TypeName: "aws_m2_application",
 			Name:     "Application",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -32,11 +33,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newDeploymentResource,
 			TypeName: "aws_m2_deployment",
 			Name:     "Deployment",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newEnvironmentResource,
 			TypeName: "aws_m2_environment",
 			Name:     "Environment",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -24,6 +24,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newOrganizationConfigurationResource,
 			TypeName: "aws_macie2_organization_configuration",
 			Name:     "Organization Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -38,16 +39,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceAccount,
 			TypeName: "aws_macie2_account",
 			Name:     "Account",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceClassificationExportConfiguration,
 			TypeName: "aws_macie2_classification_export_configuration",
 			Name:     "Classification Export Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceClassificationJob,
 			TypeName: "aws_macie2_classification_job",
 			Name:     "Classification Job",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "job_arn",
 			},
@@ -56,6 +60,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceCustomDataIdentifier,
 			TypeName: "aws_macie2_custom_data_identifier",
 			Name:     "Custom Data Identifier",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -64,6 +69,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceFindingsFilter,
 			TypeName: "aws_macie2_findings_filter",
 			Name:     "Findings Filter",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -72,11 +78,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceInvitationAccepter,
 			TypeName: "aws_macie2_invitation_accepter",
 			Name:     "Invitation Accepter",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceMember,
 			TypeName: "aws_macie2_member",
 			Name:     "Member",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -85,6 +93,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceOrganizationAdminAccount,
 			TypeName: "aws_macie2_organization_admin_account",
 			Name:     "Organization Admin Account",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_media_package_channel", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_media_package_channel", &resource.Sweeper{
 		Name: "aws_media_package_channel",
 		F:    sweepChannels,
 	})
@@ -32,6 +32,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceContainer,
 			TypeName: "aws_media_store_container",
 			Name:     "Container",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -40,6 +41,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceContainerPolicy,
 			TypeName: "aws_media_store_container_policy",
 			Name:     "Container Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -22,26 +22,31 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDefaultTagsDataSource,
 			TypeName: "aws_default_tags",
 			Name:     "Default Tags",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newIPRangesDataSource,
 			TypeName: "aws_ip_ranges",
 			Name:     "IP Ranges",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newPartitionDataSource,
 			TypeName: "aws_partition",
 			Name:     "Partition",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newRegionDataSource,
 			TypeName: "aws_region",
 			Name:     "Region",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newRegionsDataSource,
 			TypeName: "aws_regions",
 			Name:     "Regions",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newServiceDataSource,
@@ -17,6 +17,7 @@ import (
 )
 
 // @FrameworkDataSource("aws_service_principal", name="Service Principal")
+// @Region(overrideEnabled=false)
 func newServicePrincipalDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &servicePrincipalDataSource{}
 
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mgn_application_wave_association", name="Application Wave Association")
+func newApplicationWaveAssociationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &applicationWaveAssociationResource{}, nil
+}
+
+const (
+	applicationWaveAssociationIDParts = 2
+)
+
+type applicationWaveAssociationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+}
+
+func (r *applicationWaveAssociationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"wave_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *applicationWaveAssociationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data applicationWaveAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	input := &mgn.AssociateApplicationsInput{
+		ApplicationIDs: []string{data.ApplicationID.ValueString()},
+		WaveID:         data.WaveID.ValueStringPointer(),
+	}
+
+	_, err := conn.AssociateApplications(ctx, input)
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionCreating, ResNameApplicationWaveAssociation, data.ApplicationID.ValueString(), err)
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *applicationWaveAssociationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data applicationWaveAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	_, err := findApplicationWaveAssociationByTwoPartKey(ctx, conn, data.WaveID.ValueString(), data.ApplicationID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionReading, ResNameApplicationWaveAssociation, data.ApplicationID.ValueString(), err)
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *applicationWaveAssociationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data applicationWaveAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	input := &mgn.DisassociateApplicationsInput{
+		ApplicationIDs: []string{data.ApplicationID.ValueString()},
+		WaveID:         data.WaveID.ValueStringPointer(),
+	}
+
+	_, err := conn.DisassociateApplications(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionDeleting, ResNameApplicationWaveAssociation, data.ApplicationID.ValueString(), err)
+
+		return
+	}
+}
+
+func (r *applicationWaveAssociationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(request.ID, applicationWaveAssociationIDParts, false)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: wave_id,application_id. Got: %q", request.ID),
+		)
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("wave_id"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrApplicationID), parts[1])...)
+}
+
+func findApplicationWaveAssociationByTwoPartKey(ctx context.Context, conn *mgn.Client, waveID, applicationID string) (*awstypes.Application, error) {
+	output, err := findApplicationByID(ctx, conn, applicationID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.ToString(output.WaveID) != waveID {
+		return nil, &retry.NotFoundError{}
+	}
+
+	return output, nil
+}
+
+type applicationWaveAssociationResourceModel struct {
+	ApplicationID types.String `tfsdk:"application_id"`
+	WaveID        types.String `tfsdk:"wave_id"`
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmgn "github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMgnApplicationWaveAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mgn_application_wave_association.test"
+	applicationResourceName := "aws_mgn_application.test"
+	waveResourceName := "aws_mgn_wave.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationWaveAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationWaveAssociationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationWaveAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrApplicationID, applicationResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "wave_id", waveResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccApplicationWaveAssociationImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrApplicationID,
+			},
+		},
+	})
+}
+
+func TestAccMgnApplicationWaveAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mgn_application_wave_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationWaveAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationWaveAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationWaveAssociationExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmgn.ResourceApplicationWaveAssociation, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationWaveAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MgnClient(ctx)
+
+		_, err := tfmgn.FindApplicationWaveAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["wave_id"], rs.Primary.Attributes[names.AttrApplicationID])
+
+		return err
+	}
+}
+
+func testAccCheckApplicationWaveAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MgnClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mgn_application_wave_association" {
+				continue
+			}
+
+			_, err := tfmgn.FindApplicationWaveAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["wave_id"], rs.Primary.Attributes[names.AttrApplicationID])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Mgn Application Wave Association %s,%s still exists", rs.Primary.Attributes["wave_id"], rs.Primary.Attributes[names.AttrApplicationID])
+		}
+
+		return nil
+	}
+}
+
+func testAccApplicationWaveAssociationImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s,%s", rs.Primary.Attributes["wave_id"], rs.Primary.Attributes[names.AttrApplicationID]), nil
+	}
+}
+
+func testAccApplicationWaveAssociationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mgn_application" "test" {
+  name = %[1]q
+}
+
+resource "aws_mgn_wave" "test" {
+  name = %[1]q
+}
+
+resource "aws_mgn_application_wave_association" "test" {
+  application_id = aws_mgn_application.test.id
+  wave_id        = aws_mgn_wave.test.id
+}
+`, rName)
+}
@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+// Exports for use in tests only.
+const (
+	ResNameApplication                        = "Application"
+	ResNameApplicationWaveAssociation         = "Application Wave Association"
+	ResNameLaunchConfigurationTemplate        = "Launch Configuration Template"
+	ResNameReplicationConfigurationTemplate   = "Replication Configuration Template"
+	ResNameSourceServerApplicationAssociation = "Source Server Application Association"
+	ResNameWave                               = "Wave"
+
+	DSNameSourceServers = "Source Servers Data Source"
+)
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+// Exports for use in tests only.
+var (
+	ResourceApplication                        = newApplicationResource
+	ResourceApplicationWaveAssociation         = newApplicationWaveAssociationResource
+	ResourceLaunchConfigurationTemplate        = newLaunchConfigurationTemplateResource
+	ResourceReplicationConfigurationTemplate   = newReplicationConfigurationTemplateResource
+	ResourceSourceServerApplicationAssociation = newSourceServerApplicationAssociationResource
+	ResourceWave                               = newWaveResource
+
+	FindApplicationByID                                = findApplicationByID
+	FindApplicationWaveAssociationByTwoPartKey         = findApplicationWaveAssociationByTwoPartKey
+	FindLaunchConfigurationTemplateByID                = findLaunchConfigurationTemplateByID
+	FindReplicationConfigurationTemplateByID           = findReplicationConfigurationTemplateByID
+	FindSourceServerApplicationAssociationByTwoPartKey = findSourceServerApplicationAssociationByTwoPartKey
+	FindWaveByID                                       = findWaveByID
+)
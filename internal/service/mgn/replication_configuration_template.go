@@ -0,0 +1,327 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mgn_replication_configuration_template", name="Replication Configuration Template")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mgn/types;awstypes;awstypes.ReplicationConfigurationTemplate")
+// @Testing(serialize=true)
+// @Testing(preCheck=true)
+func newReplicationConfigurationTemplateResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &replicationConfigurationTemplateResource{}
+
+	return r, nil
+}
+
+type replicationConfigurationTemplateResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *replicationConfigurationTemplateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"associate_default_security_group": schema.BoolAttribute{
+				Required: true,
+			},
+			"bandwidth_throttling": schema.Int64Attribute{
+				Required: true,
+			},
+			"create_public_ip": schema.BoolAttribute{
+				Required: true,
+			},
+			"data_plane_routing": schema.StringAttribute{
+				Required:   true,
+				CustomType: fwtypes.StringEnumType[awstypes.ReplicationConfigurationDataPlaneRouting](),
+			},
+			"default_large_staging_disk_type": schema.StringAttribute{
+				Required:   true,
+				CustomType: fwtypes.StringEnumType[awstypes.ReplicationConfigurationDefaultLargeStagingDiskType](),
+			},
+			"ebs_encryption": schema.StringAttribute{
+				Required:   true,
+				CustomType: fwtypes.StringEnumType[awstypes.ReplicationConfigurationEbsEncryption](),
+			},
+			"ebs_encryption_key_arn": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"replication_server_instance_type": schema.StringAttribute{
+				Required: true,
+			},
+			"replication_servers_security_groups_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"staging_area_subnet_id": schema.StringAttribute{
+				Required: true,
+			},
+			"staging_area_tags": tftags.TagsAttributeRequired(),
+			names.AttrTags:      tftags.TagsAttribute(),
+			names.AttrTagsAll:   tftags.TagsAttributeComputedOnly(),
+			"use_dedicated_replication_server": schema.BoolAttribute{
+				Required: true,
+			},
+			"use_fips_endpoint": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+var replicationConfigurationTemplateFlexOpt = flex.WithFieldNamePrefix("ReplicationConfigurationTemplate")
+
+func (r *replicationConfigurationTemplateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data replicationConfigurationTemplateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	var input mgn.CreateReplicationConfigurationTemplateInput
+	response.Diagnostics.Append(flex.Expand(ctx, data, &input, replicationConfigurationTemplateFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateReplicationConfigurationTemplate(ctx, &input)
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionCreating, ResNameReplicationConfigurationTemplate, "", err)
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, output, &data, replicationConfigurationTemplateFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *replicationConfigurationTemplateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data replicationConfigurationTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	output, err := findReplicationConfigurationTemplateByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionReading, ResNameReplicationConfigurationTemplate, data.ID.ValueString(), err)
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, output, &data, replicationConfigurationTemplateFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *replicationConfigurationTemplateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new replicationConfigurationTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	if replicationConfigurationTemplateHasChanges(ctx, new, old) {
+		var input mgn.UpdateReplicationConfigurationTemplateInput
+		response.Diagnostics.Append(flex.Expand(ctx, new, &input, replicationConfigurationTemplateFlexOpt)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.UpdateReplicationConfigurationTemplate(ctx, &input)
+
+		if err != nil {
+			create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionUpdating, ResNameReplicationConfigurationTemplate, new.ID.ValueString(), err)
+
+			return
+		}
+
+		response.Diagnostics.Append(flex.Flatten(ctx, output, &new, replicationConfigurationTemplateFlexOpt)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *replicationConfigurationTemplateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data replicationConfigurationTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	tflog.Debug(ctx, "deleting Mgn Replication Configuration Template", map[string]any{
+		names.AttrID: data.ID.ValueString(),
+	})
+
+	input := &mgn.DeleteReplicationConfigurationTemplateInput{
+		ReplicationConfigurationTemplateID: data.ID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteReplicationConfigurationTemplate(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionDeleting, ResNameReplicationConfigurationTemplate, data.ID.ValueString(), err)
+
+		return
+	}
+}
+
+func findReplicationConfigurationTemplate(ctx context.Context, conn *mgn.Client, input *mgn.DescribeReplicationConfigurationTemplatesInput) (*awstypes.ReplicationConfigurationTemplate, error) {
+	output, err := findReplicationConfigurationTemplates(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findReplicationConfigurationTemplates(ctx context.Context, conn *mgn.Client, input *mgn.DescribeReplicationConfigurationTemplatesInput) ([]awstypes.ReplicationConfigurationTemplate, error) {
+	var output []awstypes.ReplicationConfigurationTemplate
+
+	pages := mgn.NewDescribeReplicationConfigurationTemplatesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+func findReplicationConfigurationTemplateByID(ctx context.Context, conn *mgn.Client, id string) (*awstypes.ReplicationConfigurationTemplate, error) {
+	input := &mgn.DescribeReplicationConfigurationTemplatesInput{
+		ReplicationConfigurationTemplateIDs: []string{id},
+	}
+
+	output, err := findReplicationConfigurationTemplate(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.ReplicationConfigurationTemplateID) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+type replicationConfigurationTemplateResourceModel struct {
+	ARN                                 types.String                                                                     `tfsdk:"arn"`
+	AssociateDefaultSecurityGroup       types.Bool                                                                       `tfsdk:"associate_default_security_group"`
+	BandwidthThrottling                 types.Int64                                                                      `tfsdk:"bandwidth_throttling"`
+	CreatePublicIP                      types.Bool                                                                       `tfsdk:"create_public_ip"`
+	DataPlaneRouting                    fwtypes.StringEnum[awstypes.ReplicationConfigurationDataPlaneRouting]            `tfsdk:"data_plane_routing"`
+	DefaultLargeStagingDiskType         fwtypes.StringEnum[awstypes.ReplicationConfigurationDefaultLargeStagingDiskType] `tfsdk:"default_large_staging_disk_type"`
+	EBSEncryption                       fwtypes.StringEnum[awstypes.ReplicationConfigurationEbsEncryption]               `tfsdk:"ebs_encryption"`
+	EBSEncryptionKeyARN                 types.String                                                                     `tfsdk:"ebs_encryption_key_arn"`
+	ID                                  types.String                                                                     `tfsdk:"id"`
+	ReplicationServerInstanceType       types.String                                                                     `tfsdk:"replication_server_instance_type"`
+	ReplicationServersSecurityGroupsIDs fwtypes.ListOfString                                                             `tfsdk:"replication_servers_security_groups_ids"`
+	StagingAreaSubnetID                 types.String                                                                     `tfsdk:"staging_area_subnet_id"`
+	StagingAreaTags                     tftags.Map                                                                       `tfsdk:"staging_area_tags"`
+	Tags                                tftags.Map                                                                       `tfsdk:"tags"`
+	TagsAll                             tftags.Map                                                                       `tfsdk:"tags_all"`
+	UseDedicatedReplicationServer       types.Bool                                                                       `tfsdk:"use_dedicated_replication_server"`
+	UseFIPSEndpoint                     types.Bool                                                                       `tfsdk:"use_fips_endpoint"`
+}
+
+func replicationConfigurationTemplateHasChanges(_ context.Context, plan, state replicationConfigurationTemplateResourceModel) bool {
+	return !plan.AssociateDefaultSecurityGroup.Equal(state.AssociateDefaultSecurityGroup) ||
+		!plan.BandwidthThrottling.Equal(state.BandwidthThrottling) ||
+		!plan.CreatePublicIP.Equal(state.CreatePublicIP) ||
+		!plan.DataPlaneRouting.Equal(state.DataPlaneRouting) ||
+		!plan.DefaultLargeStagingDiskType.Equal(state.DefaultLargeStagingDiskType) ||
+		!plan.EBSEncryption.Equal(state.EBSEncryption) ||
+		!plan.EBSEncryptionKeyARN.Equal(state.EBSEncryptionKeyARN) ||
+		!plan.ReplicationServerInstanceType.Equal(state.ReplicationServerInstanceType) ||
+		!plan.ReplicationServersSecurityGroupsIDs.Equal(state.ReplicationServersSecurityGroupsIDs) ||
+		!plan.StagingAreaSubnetID.Equal(state.StagingAreaSubnetID) ||
+		!plan.StagingAreaTags.Equal(state.StagingAreaTags) ||
+		!plan.UseDedicatedReplicationServer.Equal(state.UseDedicatedReplicationServer) ||
+		!plan.UseFIPSEndpoint.Equal(state.UseFIPSEndpoint)
+}
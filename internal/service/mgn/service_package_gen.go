@@ -15,11 +15,67 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newSourceServersDataSource,
+			TypeName: "aws_mgn_source_servers",
+			Name:     "Source Servers",
+			Region:   types.ResourceRegionDefault(),
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory:  newApplicationResource,
+			TypeName: "aws_mgn_application",
+			Name:     "Application",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newApplicationWaveAssociationResource,
+			TypeName: "aws_mgn_application_wave_association",
+			Name:     "Application Wave Association",
+			Region:   types.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newLaunchConfigurationTemplateResource,
+			TypeName: "aws_mgn_launch_configuration_template",
+			Name:     "Launch Configuration Template",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newReplicationConfigurationTemplateResource,
+			TypeName: "aws_mgn_replication_configuration_template",
+			Name:     "Replication Configuration Template",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newSourceServerApplicationAssociationResource,
+			TypeName: "aws_mgn_source_server_application_association",
+			Name:     "Source Server Application Association",
+			Region:   types.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newWaveResource,
+			TypeName: "aws_mgn_wave",
+			Name:     "Wave",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
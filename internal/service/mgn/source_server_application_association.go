@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mgn_source_server_application_association", name="Source Server Application Association")
+func newSourceServerApplicationAssociationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &sourceServerApplicationAssociationResource{}, nil
+}
+
+const (
+	sourceServerApplicationAssociationIDParts = 2
+)
+
+type sourceServerApplicationAssociationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+}
+
+func (r *sourceServerApplicationAssociationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_server_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *sourceServerApplicationAssociationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data sourceServerApplicationAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	input := &mgn.AssociateSourceServersInput{
+		ApplicationID:   data.ApplicationID.ValueStringPointer(),
+		SourceServerIDs: []string{data.SourceServerID.ValueString()},
+	}
+
+	_, err := conn.AssociateSourceServers(ctx, input)
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionCreating, ResNameSourceServerApplicationAssociation, data.SourceServerID.ValueString(), err)
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *sourceServerApplicationAssociationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data sourceServerApplicationAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	_, err := findSourceServerApplicationAssociationByTwoPartKey(ctx, conn, data.ApplicationID.ValueString(), data.SourceServerID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionReading, ResNameSourceServerApplicationAssociation, data.SourceServerID.ValueString(), err)
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *sourceServerApplicationAssociationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data sourceServerApplicationAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	input := &mgn.DisassociateSourceServersInput{
+		ApplicationID:   data.ApplicationID.ValueStringPointer(),
+		SourceServerIDs: []string{data.SourceServerID.ValueString()},
+	}
+
+	_, err := conn.DisassociateSourceServers(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionDeleting, ResNameSourceServerApplicationAssociation, data.SourceServerID.ValueString(), err)
+
+		return
+	}
+}
+
+func (r *sourceServerApplicationAssociationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(request.ID, sourceServerApplicationAssociationIDParts, false)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: application_id,source_server_id. Got: %q", request.ID),
+		)
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrApplicationID), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("source_server_id"), parts[1])...)
+}
+
+func findSourceServerByID(ctx context.Context, conn *mgn.Client, id string) (*awstypes.SourceServer, error) {
+	input := &mgn.DescribeSourceServersInput{
+		Filters: &awstypes.DescribeSourceServersRequestFilters{
+			SourceServerIDs: []string{id},
+		},
+	}
+
+	output, err := findSourceServers(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findSourceServerApplicationAssociationByTwoPartKey(ctx context.Context, conn *mgn.Client, applicationID, sourceServerID string) (*awstypes.SourceServer, error) {
+	output, err := findSourceServerByID(ctx, conn, sourceServerID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.ToString(output.ApplicationID) != applicationID {
+		return nil, &retry.NotFoundError{}
+	}
+
+	return output, nil
+}
+
+type sourceServerApplicationAssociationResourceModel struct {
+	ApplicationID  types.String `tfsdk:"application_id"`
+	SourceServerID types.String `tfsdk:"source_server_id"`
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmgn "github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Source servers are registered by the replication agent and cannot be created by Terraform.
+const envVarSourceServerID = "MGN_SOURCE_SERVER_ID"
+
+func TestAccMgnSourceServerApplicationAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mgn_source_server_application_association.test"
+	applicationResourceName := "aws_mgn_application.test"
+	sourceServerID := acctest.SkipIfEnvVarNotSet(t, envVarSourceServerID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSourceServerApplicationAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceServerApplicationAssociationConfig_basic(rName, sourceServerID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSourceServerApplicationAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrApplicationID, applicationResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "source_server_id", sourceServerID),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccSourceServerApplicationAssociationImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "source_server_id",
+			},
+		},
+	})
+}
+
+func testAccCheckSourceServerApplicationAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MgnClient(ctx)
+
+		_, err := tfmgn.FindSourceServerApplicationAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrApplicationID], rs.Primary.Attributes["source_server_id"])
+
+		return err
+	}
+}
+
+func testAccCheckSourceServerApplicationAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MgnClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mgn_source_server_application_association" {
+				continue
+			}
+
+			_, err := tfmgn.FindSourceServerApplicationAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrApplicationID], rs.Primary.Attributes["source_server_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Mgn Source Server Application Association %s,%s still exists", rs.Primary.Attributes[names.AttrApplicationID], rs.Primary.Attributes["source_server_id"])
+		}
+
+		return nil
+	}
+}
+
+func testAccSourceServerApplicationAssociationImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s,%s", rs.Primary.Attributes[names.AttrApplicationID], rs.Primary.Attributes["source_server_id"]), nil
+	}
+}
+
+func testAccSourceServerApplicationAssociationConfig_basic(rName, sourceServerID string) string {
+	return fmt.Sprintf(`
+resource "aws_mgn_application" "test" {
+  name = %[1]q
+}
+
+resource "aws_mgn_source_server_application_association" "test" {
+  application_id   = aws_mgn_application.test.id
+  source_server_id = %[2]q
+}
+`, rName, sourceServerID)
+}
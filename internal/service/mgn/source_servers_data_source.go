@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_mgn_source_servers", name="Source Servers")
+func newSourceServersDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &sourceServersDataSource{}, nil
+}
+
+type sourceServersDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *sourceServersDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"is_archived": schema.BoolAttribute{
+				Optional: true,
+			},
+			"lifecycle_states": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringEnumType[awstypes.LifeCycleState](),
+				ElementType: fwtypes.StringEnumType[awstypes.LifeCycleState](),
+				Optional:    true,
+			},
+			"replication_types": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringEnumType[awstypes.ReplicationType](),
+				ElementType: fwtypes.StringEnumType[awstypes.ReplicationType](),
+				Optional:    true,
+			},
+			"source_server_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"source_servers": framework.DataSourceComputedListOfObjectAttribute[sourceServerModel](ctx),
+		},
+	}
+}
+
+func (d *sourceServersDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data sourceServersDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().MgnClient(ctx)
+
+	var filters awstypes.DescribeSourceServersRequestFilters
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &filters)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &mgn.DescribeSourceServersInput{
+		Filters: &filters,
+	}
+
+	output, err := findSourceServers(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.Mgn, create.ErrActionReading, DSNameSourceServers, "", err), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.SourceServers, fwflex.WithNoIgnoredFieldNames())...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findSourceServers(ctx context.Context, conn *mgn.Client, input *mgn.DescribeSourceServersInput) ([]awstypes.SourceServer, error) {
+	var output []awstypes.SourceServer
+
+	pages := mgn.NewDescribeSourceServersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+type sourceServersDataSourceModel struct {
+	ApplicationIDs   fwtypes.ListOfString                                              `tfsdk:"application_ids"`
+	IsArchived       types.Bool                                                        `tfsdk:"is_archived"`
+	LifecycleStates  fwtypes.ListValueOf[fwtypes.StringEnum[awstypes.LifeCycleState]]  `tfsdk:"lifecycle_states"`
+	ReplicationTypes fwtypes.ListValueOf[fwtypes.StringEnum[awstypes.ReplicationType]] `tfsdk:"replication_types"`
+	SourceServerIDs  fwtypes.ListOfString                                              `tfsdk:"source_server_ids"`
+	SourceServers    fwtypes.ListNestedObjectValueOf[sourceServerModel]                `tfsdk:"source_servers"`
+}
+
+type sourceServerModel struct {
+	ApplicationID          types.String                                 `tfsdk:"application_id"`
+	ARN                    types.String                                 `tfsdk:"arn"`
+	FQDNForActionFramework types.String                                 `tfsdk:"fqdn_for_action_framework"`
+	IsArchived             types.Bool                                   `tfsdk:"is_archived"`
+	ReplicationType        fwtypes.StringEnum[awstypes.ReplicationType] `tfsdk:"replication_type"`
+	SourceServerID         types.String                                 `tfsdk:"source_server_id"`
+	Tags                   fwtypes.MapOfString                          `tfsdk:"tags"`
+	UserProvidedID         types.String                                 `tfsdk:"user_provided_id"`
+	VcenterClientID        types.String                                 `tfsdk:"vcenter_client_id"`
+}
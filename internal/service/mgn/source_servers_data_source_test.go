@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMgnSourceServersDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_mgn_source_servers.test"
+	sourceServerID := acctest.SkipIfEnvVarNotSet(t, envVarSourceServerID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceServersDataSourceConfig_basic(sourceServerID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "source_servers.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "source_servers.0.arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "source_servers.0.source_server_id", sourceServerID),
+				),
+			},
+		},
+	})
+}
+
+func testAccSourceServersDataSourceConfig_basic(sourceServerID string) string {
+	return fmt.Sprintf(`
+data "aws_mgn_source_servers" "test" {
+  source_server_ids = [%[1]q]
+}
+`, sourceServerID)
+}
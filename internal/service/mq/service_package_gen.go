@@ -28,16 +28,19 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceBroker,
 			TypeName: "aws_mq_broker",
 			Name:     "Broker",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceBrokerEngineTypes,
 			TypeName: "aws_mq_broker_engine_types",
 			Name:     "Broker Engine Types",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceBrokerInstanceTypeOfferings,
 			TypeName: "aws_mq_broker_instance_type_offerings",
 			Name:     "Broker Instance Type Offerings",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -48,6 +51,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceBroker,
 			TypeName: "aws_mq_broker",
 			Name:     "Broker",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -56,6 +60,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceConfiguration,
 			TypeName: "aws_mq_configuration",
 			Name:     "Configuration",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
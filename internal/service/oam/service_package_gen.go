@@ -28,21 +28,25 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceLink,
 			TypeName: "aws_oam_link",
 			Name:     "Link",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  DataSourceLinks,
 			TypeName: "aws_oam_links",
 			Name:     "Links",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  DataSourceSink,
 			TypeName: "aws_oam_sink",
 			Name:     "Sink",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  DataSourceSinks,
 			TypeName: "aws_oam_sinks",
 			Name:     "Sinks",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -53,6 +57,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceLink,
 			TypeName: "aws_oam_link",
 			Name:     "Link",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
@@ -61,6 +66,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceSink,
 			TypeName: "aws_oam_sink",
 			Name:     "Sink",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
@@ -69,6 +75,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceSinkPolicy,
 			TypeName: "aws_oam_sink_policy",
 			Name:     "Sink Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -20,11 +20,13 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceAccessPolicy,
 			TypeName: "aws_opensearchserverless_access_policy",
 			Name:     "Access Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newDataSourceCollection,
 			TypeName: "aws_opensearchserverless_collection",
 			Name:     "Collection",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -33,11 +35,13 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceLifecyclePolicy,
 			TypeName: "aws_opensearchserverless_lifecycle_policy",
 			Name:     "Lifecycle Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newDataSourceSecurityConfig,
 			TypeName: "aws_opensearchserverless_security_config",
 			Name:     "Security Config",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -48,11 +52,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceAccessPolicy,
 			TypeName: "aws_opensearchserverless_access_policy",
 			Name:     "Access Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceCollection,
 			TypeName: "aws_opensearchserverless_collection",
 			Name:     "Collection",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -61,21 +67,25 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceLifecyclePolicy,
 			TypeName: "aws_opensearchserverless_lifecycle_policy",
 			Name:     "Lifecycle Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceSecurityConfig,
 			TypeName: "aws_opensearchserverless_security_config",
 			Name:     "Security Config",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceSecurityPolicy,
 			TypeName: "aws_opensearchserverless_security_policy",
 			Name:     "Security Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newVPCEndpointResource,
 			TypeName: "aws_opensearchserverless_vpc_endpoint",
 			Name:     "VPC Endpoint",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -86,11 +96,13 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceSecurityPolicy,
 			TypeName: "aws_opensearchserverless_security_policy",
 			Name:     "Security Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceVPCEndpoint,
 			TypeName: "aws_opensearchserverless_vpc_endpoint",
 			Name:     "VPC Endpoint",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
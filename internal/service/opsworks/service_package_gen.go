@@ -32,11 +32,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceApplication,
 			TypeName: "aws_opsworks_application",
 			Name:     "Application",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceCustomLayer,
 			TypeName: "aws_opsworks_custom_layer",
 			Name:     "Custom Layer",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -45,6 +47,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceECSClusterLayer,
 			TypeName: "aws_opsworks_ecs_cluster_layer",
 			Name:     "ECS Cluster Layer",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -53,6 +56,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceGangliaLayer,
 			TypeName: "aws_opsworks_ganglia_layer",
 			Name:     "Ganglia Layer",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -61,6 +65,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceHAProxyLayer,
 			TypeName: "aws_opsworks_haproxy_layer",
 			Name:     "HAProxy Layer",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -69,11 +74,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceInstance,
 			TypeName: "aws_opsworks_instance",
 			Name:     "Instance",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceJavaAppLayer,
 			TypeName: "aws_opsworks_java_app_layer",
 			Name:     "Java App Layer",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -82,6 +89,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceMemcachedLayer,
 			TypeName: "aws_opsworks_memcached_layer",
 			Name:     "Memcached Layer",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -90,6 +98,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceMySQLLayer,
 			TypeName: "aws_opsworks_mysql_layer",
 			Name:     "MySQL Layer",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -98,6 +107,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceNodejsAppLayer,
 			TypeName: "aws_opsworks_nodejs_app_layer",
 			Name:     "NodeJS App Layer",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -106,11 +116,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourcePermission,
 			TypeName: "aws_opsworks_permission",
 			Name:     "Permission",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourcePHPAppLayer,
 			TypeName: "aws_opsworks_php_app_layer",
 			Name:     "PHP App Layer",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -119,6 +131,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceRailsAppLayer,
 			TypeName: "aws_opsworks_rails_app_layer",
 			Name:     "Rails App Layer",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -127,6 +140,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceRDSDBInstance,
 			TypeName: "aws_opsworks_rds_db_instance",
 			Name:     "RDS DB Instance",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceStack,
@@ -138,6 +152,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceStaticWebLayer,
 			TypeName: "aws_opsworks_static_web_layer",
 			Name:     "Static Web Layer",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -146,6 +161,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceUserProfile,
 			TypeName: "aws_opsworks_user_profile",
 			Name:     "Profile",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
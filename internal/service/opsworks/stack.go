@@ -33,6 +33,7 @@ const (
 )
 
 // @SDKResource("aws_opsworks_stack", name="Stack")
+// @Region(overrideEnabled=false)
 // @Tags
 func resourceStack() *schema.Resource {
 	return &schema.Resource{
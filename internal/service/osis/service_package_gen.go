@@ -24,6 +24,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newPipelineResource,
 			TypeName: "aws_osis_pipeline",
 			Name:     "Pipeline",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "pipeline_arn",
 			},
@@ -28,41 +28,49 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceOutpostAsset,
 			TypeName: "aws_outposts_asset",
 			Name:     "Asset",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceOutpostAssets,
 			TypeName: "aws_outposts_assets",
 			Name:     "Assets",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceOutpost,
 			TypeName: "aws_outposts_outpost",
 			Name:     "Outpost",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceOutpostInstanceType,
 			TypeName: "aws_outposts_outpost_instance_type",
 			Name:     "Outpost Instance Type",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceOutpostInstanceTypes,
 			TypeName: "aws_outposts_outpost_instance_types",
 			Name:     "Outpost Instance Types",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceOutposts,
 			TypeName: "aws_outposts_outposts",
 			Name:     "Outposts",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceSite,
 			TypeName: "aws_outposts_site",
 			Name:     "Site",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceSites,
 			TypeName: "aws_outposts_sites",
 			Name:     "Sites",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
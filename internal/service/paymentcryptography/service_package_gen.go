@@ -24,6 +24,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceKey,
 			TypeName: "aws_paymentcryptography_key",
 			Name:     "Key",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -32,6 +33,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceKeyAlias,
 			TypeName: "aws_paymentcryptography_key_alias",
 			Name:     "Key Alias",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
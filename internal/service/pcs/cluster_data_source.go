@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_pcs_cluster", name="Cluster")
+// @Tags(identifierAttribute="arn")
+func newClusterDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &clusterDataSource{}, nil
+}
+
+type clusterDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *clusterDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"cluster_identifier": schema.StringAttribute{
+				Required: true,
+			},
+			"endpoints": framework.DataSourceComputedListOfObjectAttribute[endpointModel](ctx),
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			"networking": framework.DataSourceComputedListOfObjectAttribute[networkingModel](ctx),
+			"scheduler":  framework.DataSourceComputedListOfObjectAttribute[schedulerModel](ctx),
+			names.AttrSize: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Size](),
+				Computed:   true,
+			},
+			"slurm_configuration": framework.DataSourceComputedListOfObjectAttribute[clusterSlurmConfigurationModel](ctx),
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ClusterStatus](),
+				Computed:   true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *clusterDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data clusterDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().PCSClient(ctx)
+
+	output, err := findClusterByID(ctx, conn, data.ClusterIdentifier.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCS Cluster (%s)", data.ClusterIdentifier.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type clusterDataSourceModel struct {
+	ARN                types.String                                                    `tfsdk:"arn"`
+	ClusterIdentifier  types.String                                                    `tfsdk:"cluster_identifier"`
+	Endpoints          fwtypes.ListNestedObjectValueOf[endpointModel]                  `tfsdk:"endpoints"`
+	ID                 types.String                                                    `tfsdk:"id"`
+	Name               types.String                                                    `tfsdk:"name"`
+	Networking         fwtypes.ListNestedObjectValueOf[networkingModel]                `tfsdk:"networking"`
+	Scheduler          fwtypes.ListNestedObjectValueOf[schedulerModel]                 `tfsdk:"scheduler"`
+	Size               fwtypes.StringEnum[awstypes.Size]                               `tfsdk:"size"`
+	SlurmConfiguration fwtypes.ListNestedObjectValueOf[clusterSlurmConfigurationModel] `tfsdk:"slurm_configuration"`
+	Status             fwtypes.StringEnum[awstypes.ClusterStatus]                      `tfsdk:"status"`
+	Tags               tftags.Map                                                      `tfsdk:"tags"`
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_pcs_compute_node_group", name="Compute Node Group")
+// @Tags(identifierAttribute="arn")
+func newComputeNodeGroupDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &computeNodeGroupDataSource{}, nil
+}
+
+type computeNodeGroupDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *computeNodeGroupDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ami_id": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"cluster_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"cluster_identifier": schema.StringAttribute{
+				Required: true,
+			},
+			"compute_node_group_identifier": schema.StringAttribute{
+				Required: true,
+			},
+			"custom_launch_template": framework.DataSourceComputedListOfObjectAttribute[customLaunchTemplateModel](ctx),
+			"iam_instance_profile_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Computed:   true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			"instance_configs": framework.DataSourceComputedListOfObjectAttribute[instanceConfigModel](ctx),
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			"purchase_option": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PurchaseOption](),
+				Computed:   true,
+			},
+			"scaling_configuration": framework.DataSourceComputedListOfObjectAttribute[scalingConfigurationModel](ctx),
+			"slurm_configuration":   framework.DataSourceComputedListOfObjectAttribute[computeNodeGroupSlurmConfigurationModel](ctx),
+			"spot_options":          framework.DataSourceComputedListOfObjectAttribute[spotOptionsModel](ctx),
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ComputeNodeGroupStatus](),
+				Computed:   true,
+			},
+			names.AttrSubnetIDs: schema.SetAttribute{
+				CustomType: fwtypes.SetOfStringType,
+				Computed:   true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *computeNodeGroupDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data computeNodeGroupDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().PCSClient(ctx)
+
+	input := pcs.GetComputeNodeGroupInput{
+		ClusterIdentifier:          fwflex.StringFromFramework(ctx, data.ClusterIdentifier),
+		ComputeNodeGroupIdentifier: fwflex.StringFromFramework(ctx, data.ComputeNodeGroupIdentifier),
+	}
+	output, err := findComputeNodeGroup(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCS Compute Node Group (%s)", aws.ToString(input.ComputeNodeGroupIdentifier)), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type computeNodeGroupDataSourceModel struct {
+	AMIID                      types.String                                                             `tfsdk:"ami_id"`
+	ARN                        types.String                                                             `tfsdk:"arn"`
+	ClusterID                  types.String                                                             `tfsdk:"cluster_id"`
+	ClusterIdentifier          types.String                                                             `tfsdk:"cluster_identifier"`
+	ComputeNodeGroupIdentifier types.String                                                             `tfsdk:"compute_node_group_identifier"`
+	CustomLaunchTemplate       fwtypes.ListNestedObjectValueOf[customLaunchTemplateModel]               `tfsdk:"custom_launch_template"`
+	IAMInstanceProfileARN      fwtypes.ARN                                                              `tfsdk:"iam_instance_profile_arn"`
+	ID                         types.String                                                             `tfsdk:"id"`
+	InstanceConfigs            fwtypes.ListNestedObjectValueOf[instanceConfigModel]                     `tfsdk:"instance_configs"`
+	Name                       types.String                                                             `tfsdk:"name"`
+	PurchaseOption             fwtypes.StringEnum[awstypes.PurchaseOption]                              `tfsdk:"purchase_option"`
+	ScalingConfiguration       fwtypes.ListNestedObjectValueOf[scalingConfigurationModel]               `tfsdk:"scaling_configuration"`
+	SlurmConfiguration         fwtypes.ListNestedObjectValueOf[computeNodeGroupSlurmConfigurationModel] `tfsdk:"slurm_configuration"`
+	SpotOptions                fwtypes.ListNestedObjectValueOf[spotOptionsModel]                        `tfsdk:"spot_options"`
+	Status                     fwtypes.StringEnum[awstypes.ComputeNodeGroupStatus]                      `tfsdk:"status"`
+	SubnetIDs                  fwtypes.SetOfString                                                      `tfsdk:"subnet_ids"`
+	Tags                       tftags.Map                                                               `tfsdk:"tags"`
+}
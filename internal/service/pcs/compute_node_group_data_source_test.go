@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPCSComputeNodeGroupDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_pcs_compute_node_group.test"
+	resourceName := "aws_pcs_compute_node_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComputeNodeGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeNodeGroupDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "ami_id", resourceName, "ami_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster_id", resourceName, "cluster_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, resourceName, "compute_node_group_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "iam_instance_profile_arn", resourceName, "iam_instance_profile_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_configs.#", resourceName, "instance_configs.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "scaling_configuration.#", resourceName, "scaling_configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrStatus, resourceName, names.AttrStatus),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subnet_ids.#", resourceName, "subnet_ids.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputeNodeGroupDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccComputeNodeGroupConfig_basic(rName, 0, 1), `
+data "aws_pcs_compute_node_group" "test" {
+  cluster_identifier            = aws_pcs_compute_node_group.test.cluster_id
+  compute_node_group_identifier = aws_pcs_compute_node_group.test.name
+}
+`)
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_pcs_queue", name="Queue")
+// @Tags(identifierAttribute="arn")
+func newQueueDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &queueDataSource{}, nil
+}
+
+type queueDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *queueDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"cluster_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"cluster_identifier": schema.StringAttribute{
+				Required: true,
+			},
+			"compute_node_group_configurations": framework.DataSourceComputedListOfObjectAttribute[computeNodeGroupConfigurationModel](ctx),
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			"queue_identifier": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.QueueStatus](),
+				Computed:   true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *queueDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data queueDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().PCSClient(ctx)
+
+	input := pcs.GetQueueInput{
+		ClusterIdentifier: fwflex.StringFromFramework(ctx, data.ClusterIdentifier),
+		QueueIdentifier:   fwflex.StringFromFramework(ctx, data.QueueIdentifier),
+	}
+	output, err := findQueue(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCS Queue (%s)", aws.ToString(input.QueueIdentifier)), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type queueDataSourceModel struct {
+	ARN                            types.String                                                        `tfsdk:"arn"`
+	ClusterID                      types.String                                                        `tfsdk:"cluster_id"`
+	ClusterIdentifier              types.String                                                        `tfsdk:"cluster_identifier"`
+	ComputeNodeGroupConfigurations fwtypes.ListNestedObjectValueOf[computeNodeGroupConfigurationModel] `tfsdk:"compute_node_group_configurations"`
+	ID                             types.String                                                        `tfsdk:"id"`
+	Name                           types.String                                                        `tfsdk:"name"`
+	QueueIdentifier                types.String                                                        `tfsdk:"queue_identifier"`
+	Status                         fwtypes.StringEnum[awstypes.QueueStatus]                            `tfsdk:"status"`
+	Tags                           tftags.Map                                                          `tfsdk:"tags"`
+}
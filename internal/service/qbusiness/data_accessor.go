@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_qbusiness_data_accessor", name="Data Accessor")
+// @Tags(identifierAttribute="arn")
+func newResourceDataAccessor(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceDataAccessor{}, nil
+}
+
+const (
+	ResNameDataAccessor = "Data Accessor"
+
+	dataAccessorResourceIDPartCount = 2
+)
+
+type resourceDataAccessor struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceDataAccessor) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Description: "Identifier of the Amazon Q application the data accessor is attached to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"data_accessor_id": schema.StringAttribute{
+				Description: "Identifier of the data accessor.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Description: "The display name of the data accessor.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^\P{C}*$`), "must not contain control characters"),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"idc_application_arn": schema.StringAttribute{
+				Description: "The ARN of the IAM Identity Center application created for the data accessor.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrPrincipal: schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the IAM role for the ISV that will be accessing the data.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"action_configuration": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[actionConfigurationData](ctx),
+				Description: "The actions the data accessor is allowed to perform.",
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 10),
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrAction: schema.StringAttribute{
+							Description: "The Amazon Q Business action that is allowed, e.g. `qbusiness:SearchRelevantContent`.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexache.MustCompile(`^qbusiness:[a-zA-Z]+$`), "must be an Amazon Q Business action"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceDataAccessor) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resourceDataAccessorData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.CreateDataAccessorInput{}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.Tags = getTagsIn(ctx)
+	input.ClientToken = aws.String(id.UniqueId())
+
+	out, err := conn.CreateDataAccessor(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionCreating, ResNameDataAccessor, data.DisplayName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	id, _ := intflex.FlattenResourceId([]string{data.ApplicationId.ValueString(), aws.ToString(out.DataAccessorId)}, dataAccessorResourceIDPartCount, false)
+
+	// Set unknown values
+	data.DataAccessorArn = fwflex.StringToFramework(ctx, out.DataAccessorArn)
+	data.DataAccessorId = fwflex.StringToFramework(ctx, out.DataAccessorId)
+	data.ID = types.StringValue(id)
+	data.IdcApplicationArn = fwflex.StringToFramework(ctx, out.IdcApplicationArn)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDataAccessor) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resourceDataAccessorData
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	parts, err := intflex.ExpandResourceId(data.ID.ValueString(), dataAccessorResourceIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionReading, ResNameDataAccessor, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	out, err := findDataAccessorByTwoPartKey(ctx, conn, parts[0], parts[1])
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionReading, ResNameDataAccessor, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDataAccessor) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan resourceDataAccessorData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.ActionConfigurations.Equal(plan.ActionConfigurations) ||
+		!state.DisplayName.Equal(plan.DisplayName) {
+		conn := r.Meta().QBusinessClient(ctx)
+
+		input := &qbusiness.UpdateDataAccessorInput{}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, plan, input)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateDataAccessor(ctx, input)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionUpdating, ResNameDataAccessor, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceDataAccessor) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resourceDataAccessorData
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.DeleteDataAccessorInput{
+		ApplicationId:  data.ApplicationId.ValueStringPointer(),
+		DataAccessorId: data.DataAccessorId.ValueStringPointer(),
+	}
+
+	if _, err := conn.DeleteDataAccessor(ctx, input); err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionDeleting, ResNameDataAccessor, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func findDataAccessorByTwoPartKey(ctx context.Context, conn *qbusiness.Client, applicationID, dataAccessorID string) (*qbusiness.GetDataAccessorOutput, error) {
+	input := &qbusiness.GetDataAccessorInput{
+		ApplicationId:  aws.String(applicationID),
+		DataAccessorId: aws.String(dataAccessorID),
+	}
+
+	output, err := conn.GetDataAccessor(ctx, input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type resourceDataAccessorData struct {
+	ActionConfigurations fwtypes.ListNestedObjectValueOf[actionConfigurationData] `tfsdk:"action_configuration"`
+	ApplicationId        types.String                                             `tfsdk:"application_id"`
+	DataAccessorArn      types.String                                             `tfsdk:"arn"`
+	DataAccessorId       types.String                                             `tfsdk:"data_accessor_id"`
+	DisplayName          types.String                                             `tfsdk:"display_name"`
+	ID                   types.String                                             `tfsdk:"id"`
+	IdcApplicationArn    types.String                                             `tfsdk:"idc_application_arn"`
+	Principal            fwtypes.ARN                                              `tfsdk:"principal"`
+	Tags                 tftags.Map                                               `tfsdk:"tags"`
+	TagsAll              tftags.Map                                               `tfsdk:"tags_all"`
+}
+
+type actionConfigurationData struct {
+	Action types.String `tfsdk:"action"`
+}
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfqbusiness "github.com/hashicorp/terraform-provider-aws/internal/service/qbusiness"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQBusinessDataAccessor_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataAccessor qbusiness.GetDataAccessorOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_data_accessor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckApplication(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataAccessorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataAccessorConfig_basic(rName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataAccessorExists(ctx, resourceName, &dataAccessor),
+					resource.TestCheckResourceAttr(resourceName, "action_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action_configuration.0.action", "qbusiness:SearchRelevantContent"),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_qbusiness_application.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "data_accessor_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "idc_application_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrPrincipal, "aws_iam_role.accessor", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataAccessorConfig_basic(rName, "display-name-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataAccessorExists(ctx, resourceName, &dataAccessor),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, "display-name-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQBusinessDataAccessor_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataAccessor qbusiness.GetDataAccessorOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_data_accessor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckApplication(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataAccessorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataAccessorConfig_basic(rName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataAccessorExists(ctx, resourceName, &dataAccessor),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfqbusiness.ResourceDataAccessor, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDataAccessorDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QBusinessClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_qbusiness_data_accessor" {
+				continue
+			}
+
+			_, err := tfqbusiness.FindDataAccessorByTwoPartKey(ctx, conn, rs.Primary.Attributes["application_id"], rs.Primary.Attributes["data_accessor_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Amazon Q Data Accessor %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDataAccessorExists(ctx context.Context, n string, v *qbusiness.GetDataAccessorOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QBusinessClient(ctx)
+
+		output, err := tfqbusiness.FindDataAccessorByTwoPartKey(ctx, conn, rs.Primary.Attributes["application_id"], rs.Primary.Attributes["data_accessor_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDataAccessorConfig_basic(rName, displayName string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_basic(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_iam_role" "accessor" {
+  name = "%[1]s-accessor"
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Action = "sts:AssumeRole"
+        Principal = {
+          AWS = data.aws_caller_identity.current.account_id
+        }
+        Effect = "Allow"
+      }
+    ]
+  })
+}
+
+resource "aws_qbusiness_data_accessor" "test" {
+  application_id = aws_qbusiness_application.test.id
+  display_name   = %[2]q
+  principal      = aws_iam_role.accessor.arn
+
+  action_configuration {
+    action = "qbusiness:SearchRelevantContent"
+  }
+}
+`, rName, displayName))
+}
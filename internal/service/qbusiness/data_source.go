@@ -0,0 +1,442 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness/document"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_qbusiness_data_source", name="Data Source")
+// @Tags(identifierAttribute="arn")
+func newResourceDataSource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceDataSource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameDataSource = "Data Source"
+
+	dataSourceResourceIDPartCount = 3
+)
+
+type resourceDataSource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceDataSource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Description: "Identifier of the Amazon Q application the data source will be attached to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrConfiguration: schema.StringAttribute{
+				CustomType:  fwtypes.NewSmithyJSONType(ctx, document.NewLazyDocument),
+				Description: "JSON configuration of the data source connector, e.g. for Amazon S3, Confluence or Microsoft SharePoint.",
+				Required:    true,
+			},
+			"data_source_id": schema.StringAttribute{
+				Description: "Identifier of the data source.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Description: "A description of the data source.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 1000),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^\P{C}*$`), "must not contain control characters"),
+				},
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Description: "The display name of the data source.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1000),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^\P{C}*$`), "must not contain control characters"),
+				},
+			},
+			"iam_service_role_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of an IAM role with permission to access the data source and required resources.",
+				Optional:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"index_id": schema.StringAttribute{
+				Description: "Identifier of the index the data source is attached to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sync_schedule": schema.StringAttribute{
+				Description: "Frequency for Amazon Q to check the documents in the data source repository and update the index, as a cron expression.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(998),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				Description: "The type of the data source connector.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrVPCConfiguration: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataSourceVPCConfigurationData](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrSecurityGroupIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Description: "A list of identifiers of security groups within your Amazon VPC.",
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeBetween(1, 10),
+							},
+						},
+						names.AttrSubnetIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Description: "A list of identifiers for subnets within your Amazon VPC.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceDataSource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resourceDataSourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.CreateDataSourceInput{}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.Tags = getTagsIn(ctx)
+	input.ClientToken = aws.String(id.UniqueId())
+
+	out, err := conn.CreateDataSource(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionCreating, ResNameDataSource, data.DisplayName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	appID, indexID, dataSourceID := data.ApplicationId.ValueString(), data.IndexId.ValueString(), aws.ToString(out.DataSourceId)
+	id, _ := intflex.FlattenResourceId([]string{appID, indexID, dataSourceID}, dataSourceResourceIDPartCount, false)
+	resp.State.SetAttribute(ctx, path.Root(names.AttrID), id)
+
+	findOut, err := waitDataSourceActive(ctx, conn, appID, indexID, dataSourceID, r.CreateTimeout(ctx, data.Timeouts))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForCreation, ResNameDataSource, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set unknown values
+	data.DataSourceArn = fwflex.StringToFramework(ctx, findOut.DataSourceArn)
+	data.DataSourceId = fwflex.StringToFramework(ctx, findOut.DataSourceId)
+	data.ID = types.StringValue(id)
+	data.Type = fwflex.StringToFramework(ctx, findOut.Type)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDataSource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resourceDataSourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	parts, err := intflex.ExpandResourceId(data.ID.ValueString(), dataSourceResourceIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionReading, ResNameDataSource, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	out, err := findDataSourceByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionReading, ResNameDataSource, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDataSource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan resourceDataSourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Configuration.Equal(plan.Configuration) ||
+		!state.Description.Equal(plan.Description) ||
+		!state.DisplayName.Equal(plan.DisplayName) ||
+		!state.RoleArn.Equal(plan.RoleArn) ||
+		!state.SyncSchedule.Equal(plan.SyncSchedule) ||
+		!state.VpcConfiguration.Equal(plan.VpcConfiguration) {
+		conn := r.Meta().QBusinessClient(ctx)
+
+		input := &qbusiness.UpdateDataSourceInput{}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, plan, input)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateDataSource(ctx, input)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionUpdating, ResNameDataSource, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		if _, err := waitDataSourceActive(ctx, conn, plan.ApplicationId.ValueString(), plan.IndexId.ValueString(), plan.DataSourceId.ValueString(), r.UpdateTimeout(ctx, plan.Timeouts)); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForUpdate, ResNameDataSource, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceDataSource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resourceDataSourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	appID, indexID, dataSourceID := data.ApplicationId.ValueString(), data.IndexId.ValueString(), data.DataSourceId.ValueString()
+	input := &qbusiness.DeleteDataSourceInput{
+		ApplicationId: aws.String(appID),
+		DataSourceId:  aws.String(dataSourceID),
+		IndexId:       aws.String(indexID),
+	}
+
+	if _, err := conn.DeleteDataSource(ctx, input); err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionDeleting, ResNameDataSource, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := waitDataSourceDeleted(ctx, conn, appID, indexID, dataSourceID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForDeletion, ResNameDataSource, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func findDataSourceByThreePartKey(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string) (*qbusiness.GetDataSourceOutput, error) {
+	input := &qbusiness.GetDataSourceInput{
+		ApplicationId: aws.String(applicationID),
+		DataSourceId:  aws.String(dataSourceID),
+		IndexId:       aws.String(indexID),
+	}
+
+	output, err := conn.GetDataSource(ctx, input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusDataSource(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findDataSourceByThreePartKey(ctx, conn, applicationID, indexID, dataSourceID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDataSourceActive(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string, timeout time.Duration) (*qbusiness.GetDataSourceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DataSourceStatusPendingCreation, awstypes.DataSourceStatusCreating, awstypes.DataSourceStatusUpdating),
+		Target:     enum.Slice(awstypes.DataSourceStatusActive),
+		Refresh:    statusDataSource(ctx, conn, applicationID, indexID, dataSourceID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetDataSourceOutput); ok {
+		if output.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Error.ErrorMessage)))
+		}
+
+		return output, err
+	}
+	return nil, err
+}
+
+func waitDataSourceDeleted(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string, timeout time.Duration) (*qbusiness.GetDataSourceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DataSourceStatusActive, awstypes.DataSourceStatusDeleting),
+		Target:     []string{},
+		Refresh:    statusDataSource(ctx, conn, applicationID, indexID, dataSourceID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetDataSourceOutput); ok {
+		if output.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Error.ErrorMessage)))
+		}
+
+		return output, err
+	}
+	return nil, err
+}
+
+type resourceDataSourceData struct {
+	ApplicationId    types.String                                                    `tfsdk:"application_id"`
+	Configuration    fwtypes.SmithyJSON[document.Interface]                          `tfsdk:"configuration"`
+	DataSourceArn    types.String                                                    `tfsdk:"arn"`
+	DataSourceId     types.String                                                    `tfsdk:"data_source_id"`
+	Description      types.String                                                    `tfsdk:"description"`
+	DisplayName      types.String                                                    `tfsdk:"display_name"`
+	ID               types.String                                                    `tfsdk:"id"`
+	IndexId          types.String                                                    `tfsdk:"index_id"`
+	RoleArn          fwtypes.ARN                                                     `tfsdk:"iam_service_role_arn"`
+	SyncSchedule     types.String                                                    `tfsdk:"sync_schedule"`
+	Tags             tftags.Map                                                      `tfsdk:"tags"`
+	TagsAll          tftags.Map                                                      `tfsdk:"tags_all"`
+	Timeouts         timeouts.Value                                                  `tfsdk:"timeouts"`
+	Type             types.String                                                    `tfsdk:"type"`
+	VpcConfiguration fwtypes.ListNestedObjectValueOf[dataSourceVPCConfigurationData] `tfsdk:"vpc_configuration"`
+}
+
+type dataSourceVPCConfigurationData struct {
+	SecurityGroupIds fwtypes.SetOfString `tfsdk:"security_group_ids"`
+	SubnetIds        fwtypes.SetOfString `tfsdk:"subnet_ids"`
+}
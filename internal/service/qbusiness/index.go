@@ -0,0 +1,412 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_qbusiness_index", name="Index")
+// @Tags(identifierAttribute="arn")
+func newResourceIndex(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceIndex{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameIndex = "Index"
+
+	indexResourceIDPartCount = 2
+)
+
+type resourceIndex struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceIndex) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Description: "Identifier of the Amazon Q application associated with the index.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Description: "A description of the index.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 1000),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^\P{C}*$`), "must not contain control characters"),
+				},
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Description: "The display name of the index.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1000),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^\P{C}*$`), "must not contain control characters"),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"index_id": schema.StringAttribute{
+				Description: "Identifier of the index.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.IndexType](),
+				Description: "The index type that's suitable for your needs.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"capacity_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[indexCapacityConfigurationData](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"units": schema.Int32Attribute{
+							Description: "The number of additional storage units for the Amazon Q index.",
+							Required:    true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceIndex) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resourceIndexData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.CreateIndexInput{}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.Tags = getTagsIn(ctx)
+	input.ClientToken = aws.String(id.UniqueId())
+
+	out, err := conn.CreateIndex(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionCreating, ResNameIndex, data.DisplayName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	appID, indexID := data.ApplicationId.ValueString(), aws.ToString(out.IndexId)
+	id, _ := intflex.FlattenResourceId([]string{appID, indexID}, indexResourceIDPartCount, false)
+	resp.State.SetAttribute(ctx, path.Root(names.AttrID), id)
+
+	if _, err := waitIndexActive(ctx, conn, appID, indexID, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForCreation, ResNameIndex, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	findOut, err := findIndexByTwoPartKey(ctx, conn, appID, indexID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionCreating, ResNameIndex, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set unknown values
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, findOut, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceIndex) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resourceIndexData
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	parts, err := intflex.ExpandResourceId(data.ID.ValueString(), indexResourceIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionReading, ResNameIndex, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	out, err := findIndexByTwoPartKey(ctx, conn, parts[0], parts[1])
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionReading, ResNameIndex, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceIndex) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan resourceIndexData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.CapacityConfiguration.Equal(plan.CapacityConfiguration) ||
+		!state.Description.Equal(plan.Description) ||
+		!state.DisplayName.Equal(plan.DisplayName) {
+		conn := r.Meta().QBusinessClient(ctx)
+
+		input := &qbusiness.UpdateIndexInput{}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, plan, input)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateIndex(ctx, input)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionUpdating, ResNameIndex, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		appID, indexID := plan.ApplicationId.ValueString(), plan.IndexId.ValueString()
+		if _, err := waitIndexActive(ctx, conn, appID, indexID, r.UpdateTimeout(ctx, plan.Timeouts)); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForUpdate, ResNameIndex, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceIndex) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resourceIndexData
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	appID, indexID := data.ApplicationId.ValueString(), data.IndexId.ValueString()
+	input := &qbusiness.DeleteIndexInput{
+		ApplicationId: aws.String(appID),
+		IndexId:       aws.String(indexID),
+	}
+
+	if _, err := conn.DeleteIndex(ctx, input); err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionDeleting, ResNameIndex, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := waitIndexDeleted(ctx, conn, appID, indexID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForDeletion, ResNameIndex, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func findIndexByTwoPartKey(ctx context.Context, conn *qbusiness.Client, applicationID, indexID string) (*qbusiness.GetIndexOutput, error) {
+	input := &qbusiness.GetIndexInput{
+		ApplicationId: aws.String(applicationID),
+		IndexId:       aws.String(indexID),
+	}
+
+	output, err := conn.GetIndex(ctx, input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusIndex(ctx context.Context, conn *qbusiness.Client, applicationID, indexID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findIndexByTwoPartKey(ctx, conn, applicationID, indexID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitIndexActive(ctx context.Context, conn *qbusiness.Client, applicationID, indexID string, timeout time.Duration) (*qbusiness.GetIndexOutput, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.IndexStatusCreating, awstypes.IndexStatusUpdating),
+		Target:     enum.Slice(awstypes.IndexStatusActive),
+		Refresh:    statusIndex(ctx, conn, applicationID, indexID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetIndexOutput); ok {
+		if output.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Error.ErrorMessage)))
+		}
+
+		return output, err
+	}
+	return nil, err
+}
+
+func waitIndexDeleted(ctx context.Context, conn *qbusiness.Client, applicationID, indexID string, timeout time.Duration) (*qbusiness.GetIndexOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.IndexStatusActive, awstypes.IndexStatusDeleting),
+		Target:     []string{},
+		Refresh:    statusIndex(ctx, conn, applicationID, indexID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetIndexOutput); ok {
+		if output.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Error.ErrorMessage)))
+		}
+
+		return output, err
+	}
+	return nil, err
+}
+
+type resourceIndexData struct {
+	ApplicationId         types.String                                                    `tfsdk:"application_id"`
+	CapacityConfiguration fwtypes.ListNestedObjectValueOf[indexCapacityConfigurationData] `tfsdk:"capacity_configuration"`
+	Description           types.String                                                    `tfsdk:"description"`
+	DisplayName           types.String                                                    `tfsdk:"display_name"`
+	ID                    types.String                                                    `tfsdk:"id"`
+	IndexArn              types.String                                                    `tfsdk:"arn"`
+	IndexId               types.String                                                    `tfsdk:"index_id"`
+	Tags                  tftags.Map                                                      `tfsdk:"tags"`
+	TagsAll               tftags.Map                                                      `tfsdk:"tags_all"`
+	Timeouts              timeouts.Value                                                  `tfsdk:"timeouts"`
+	Type                  fwtypes.StringEnum[awstypes.IndexType]                          `tfsdk:"type"`
+}
+
+type indexCapacityConfigurationData struct {
+	Units types.Int32 `tfsdk:"units"`
+}
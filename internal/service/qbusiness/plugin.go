@@ -0,0 +1,739 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_qbusiness_plugin", name="Plugin")
+// @Tags(identifierAttribute="arn")
+func newResourcePlugin(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourcePlugin{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNamePlugin = "Plugin"
+
+	pluginResourceIDPartCount = 2
+)
+
+type resourcePlugin struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourcePlugin) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Description: "Identifier of the Amazon Q application the plugin will be attached to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"build_status": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.PluginBuildStatus](),
+				Description: "The current status of a plugin. A plugin is modified asynchronously.",
+				Computed:    true,
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Description: "The display name of the plugin.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^\P{C}*$`), "must not contain control characters"),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"plugin_id": schema.StringAttribute{
+				Description: "Identifier of the plugin.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_url": schema.StringAttribute{
+				Description: "Source URL used for plugin configuration.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 2048),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^(https?|ftp|file)://([^\s]*)$`), "must be a valid URL"),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.PluginState](),
+				Description: "The state of the plugin.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.PluginType](),
+				Description: "The type of plugin.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"auth_configuration": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[pluginAuthConfigurationData](ctx),
+				Description: "Authentication configuration information for the plugin. An empty block configures a plugin that requires no authentication.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"basic_auth_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[basicAuthConfigurationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ConflictsWith(
+									path.MatchRelative().AtParent().AtName("idc_auth_configuration"),
+									path.MatchRelative().AtParent().AtName("oauth2_client_credential_configuration"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrRoleARN: schema.StringAttribute{
+										CustomType:  fwtypes.ARNType,
+										Description: "The ARN of an IAM role used by Amazon Q to access the basic authentication credentials stored in a Secrets Manager secret.",
+										Required:    true,
+									},
+									"secret_arn": schema.StringAttribute{
+										CustomType:  fwtypes.ARNType,
+										Description: "The ARN of the Secrets Manager secret that stores the basic authentication credentials used for plugin configuration.",
+										Required:    true,
+									},
+								},
+							},
+						},
+						"idc_auth_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[idcAuthConfigurationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ConflictsWith(
+									path.MatchRelative().AtParent().AtName("oauth2_client_credential_configuration"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"idc_application_arn": schema.StringAttribute{
+										CustomType:  fwtypes.ARNType,
+										Description: "The ARN of the IAM Identity Center application used to configure authentication.",
+										Required:    true,
+									},
+									names.AttrRoleARN: schema.StringAttribute{
+										CustomType:  fwtypes.ARNType,
+										Description: "The ARN of the IAM role with permissions to perform actions on AWS services on your behalf.",
+										Required:    true,
+									},
+								},
+							},
+						},
+						"oauth2_client_credential_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[oauth2ClientCredentialConfigurationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"authorization_url": schema.StringAttribute{
+										Description: "The redirect URL required by the OAuth 2.0 protocol for Amazon Q to authenticate a plugin user through a third party authentication server.",
+										Optional:    true,
+									},
+									names.AttrRoleARN: schema.StringAttribute{
+										CustomType:  fwtypes.ARNType,
+										Description: "The ARN of an IAM role used by Amazon Q to access the OAuth 2.0 authentication credentials stored in a Secrets Manager secret.",
+										Required:    true,
+									},
+									"secret_arn": schema.StringAttribute{
+										CustomType:  fwtypes.ARNType,
+										Description: "The ARN of the Secrets Manager secret that stores the OAuth 2.0 credentials/token used for plugin configuration.",
+										Required:    true,
+									},
+									"token_url": schema.StringAttribute{
+										Description: "The URL required by the OAuth 2.0 protocol to exchange an end user authorization code for an access token.",
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"custom_plugin_configuration": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[customPluginConfigurationData](ctx),
+				Description: "Configuration information required to create a custom plugin.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"api_schema_type": schema.StringAttribute{
+							CustomType:  fwtypes.StringEnumType[awstypes.APISchemaType](),
+							Description: "The type of OpenAPI schema to use.",
+							Required:    true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Description: "A description for your custom plugin configuration.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 200),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"api_schema": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[apiSchemaData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.IsRequired(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"payload": schema.StringAttribute{
+										Description: "JSON or YAML-formatted payload defining the OpenAPI schema for a custom plugin.",
+										Optional:    true,
+										Validators: []validator.String{
+											stringvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("s3"),
+											),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"s3": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[s3Data](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrBucket: schema.StringAttribute{
+													Description: "The name of the S3 bucket that contains the file.",
+													Required:    true,
+												},
+												names.AttrKey: schema.StringAttribute{
+													Description: "The name of the file.",
+													Required:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourcePlugin) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resourcePluginData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.CreatePluginInput{}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.Tags = getTagsIn(ctx)
+	input.ClientToken = aws.String(id.UniqueId())
+
+	out, err := conn.CreatePlugin(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionCreating, ResNamePlugin, data.DisplayName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	appID, pluginID := data.ApplicationId.ValueString(), aws.ToString(out.PluginId)
+	id, _ := intflex.FlattenResourceId([]string{appID, pluginID}, pluginResourceIDPartCount, false)
+	resp.State.SetAttribute(ctx, path.Root(names.AttrID), id)
+
+	// The plugin state can only be set via UpdatePlugin.
+	if !data.State.IsUnknown() && !data.State.IsNull() {
+		updateInput := &qbusiness.UpdatePluginInput{
+			ApplicationId: aws.String(appID),
+			PluginId:      aws.String(pluginID),
+			State:         data.State.ValueEnum(),
+		}
+
+		if _, err := waitPluginReady(ctx, conn, appID, pluginID, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForCreation, ResNamePlugin, id, err),
+				err.Error(),
+			)
+			return
+		}
+
+		if _, err := conn.UpdatePlugin(ctx, updateInput); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionCreating, ResNamePlugin, id, err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	findOut, err := waitPluginReady(ctx, conn, appID, pluginID, r.CreateTimeout(ctx, data.Timeouts))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForCreation, ResNamePlugin, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set unknown values
+	data.BuildStatus = fwtypes.StringEnumValue(findOut.BuildStatus)
+	data.ID = types.StringValue(id)
+	data.PluginArn = fwflex.StringToFramework(ctx, findOut.PluginArn)
+	data.PluginId = fwflex.StringToFramework(ctx, findOut.PluginId)
+	data.State = fwtypes.StringEnumValue(findOut.State)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourcePlugin) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resourcePluginData
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	parts, err := intflex.ExpandResourceId(data.ID.ValueString(), pluginResourceIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionReading, ResNamePlugin, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	out, err := findPluginByTwoPartKey(ctx, conn, parts[0], parts[1])
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionReading, ResNamePlugin, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourcePlugin) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan resourcePluginData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.AuthConfiguration.Equal(plan.AuthConfiguration) ||
+		!state.CustomPluginConfiguration.Equal(plan.CustomPluginConfiguration) ||
+		!state.DisplayName.Equal(plan.DisplayName) ||
+		!state.ServerUrl.Equal(plan.ServerUrl) ||
+		!state.State.Equal(plan.State) {
+		conn := r.Meta().QBusinessClient(ctx)
+
+		input := &qbusiness.UpdatePluginInput{}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, plan, input)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdatePlugin(ctx, input)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionUpdating, ResNamePlugin, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		findOut, err := waitPluginReady(ctx, conn, plan.ApplicationId.ValueString(), plan.PluginId.ValueString(), r.UpdateTimeout(ctx, plan.Timeouts))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForUpdate, ResNamePlugin, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		// Set unknown values
+		plan.BuildStatus = fwtypes.StringEnumValue(findOut.BuildStatus)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourcePlugin) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resourcePluginData
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	appID, pluginID := data.ApplicationId.ValueString(), data.PluginId.ValueString()
+	input := &qbusiness.DeletePluginInput{
+		ApplicationId: aws.String(appID),
+		PluginId:      aws.String(pluginID),
+	}
+
+	if _, err := conn.DeletePlugin(ctx, input); err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionDeleting, ResNamePlugin, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := waitPluginDeleted(ctx, conn, appID, pluginID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForDeletion, ResNamePlugin, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func findPluginByTwoPartKey(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string) (*qbusiness.GetPluginOutput, error) {
+	input := &qbusiness.GetPluginInput{
+		ApplicationId: aws.String(applicationID),
+		PluginId:      aws.String(pluginID),
+	}
+
+	output, err := conn.GetPlugin(ctx, input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusPlugin(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findPluginByTwoPartKey(ctx, conn, applicationID, pluginID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.BuildStatus), nil
+	}
+}
+
+func waitPluginReady(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string, timeout time.Duration) (*qbusiness.GetPluginOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.PluginBuildStatusCreateInProgress, awstypes.PluginBuildStatusUpdateInProgress),
+		Target:     enum.Slice(awstypes.PluginBuildStatusReady),
+		Refresh:    statusPlugin(ctx, conn, applicationID, pluginID),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetPluginOutput); ok {
+		return output, err
+	}
+	return nil, err
+}
+
+func waitPluginDeleted(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string, timeout time.Duration) (*qbusiness.GetPluginOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.PluginBuildStatusReady, awstypes.PluginBuildStatusDeleteInProgress),
+		Target:     []string{},
+		Refresh:    statusPlugin(ctx, conn, applicationID, pluginID),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetPluginOutput); ok {
+		return output, err
+	}
+	return nil, err
+}
+
+type resourcePluginData struct {
+	ApplicationId             types.String                                                   `tfsdk:"application_id"`
+	AuthConfiguration         fwtypes.ListNestedObjectValueOf[pluginAuthConfigurationData]   `tfsdk:"auth_configuration"`
+	BuildStatus               fwtypes.StringEnum[awstypes.PluginBuildStatus]                 `tfsdk:"build_status"`
+	CustomPluginConfiguration fwtypes.ListNestedObjectValueOf[customPluginConfigurationData] `tfsdk:"custom_plugin_configuration"`
+	DisplayName               types.String                                                   `tfsdk:"display_name"`
+	ID                        types.String                                                   `tfsdk:"id"`
+	PluginArn                 types.String                                                   `tfsdk:"arn"`
+	PluginId                  types.String                                                   `tfsdk:"plugin_id"`
+	ServerUrl                 types.String                                                   `tfsdk:"server_url"`
+	State                     fwtypes.StringEnum[awstypes.PluginState]                       `tfsdk:"state"`
+	Tags                      tftags.Map                                                     `tfsdk:"tags"`
+	TagsAll                   tftags.Map                                                     `tfsdk:"tags_all"`
+	Timeouts                  timeouts.Value                                                 `tfsdk:"timeouts"`
+	Type                      fwtypes.StringEnum[awstypes.PluginType]                        `tfsdk:"type"`
+}
+
+var (
+	_ fwflex.Expander  = pluginAuthConfigurationData{}
+	_ fwflex.Flattener = &pluginAuthConfigurationData{}
+)
+
+type pluginAuthConfigurationData struct {
+	BasicAuthConfiguration              fwtypes.ListNestedObjectValueOf[basicAuthConfigurationData]              `tfsdk:"basic_auth_configuration"`
+	IdcAuthConfiguration                fwtypes.ListNestedObjectValueOf[idcAuthConfigurationData]                `tfsdk:"idc_auth_configuration"`
+	OAuth2ClientCredentialConfiguration fwtypes.ListNestedObjectValueOf[oauth2ClientCredentialConfigurationData] `tfsdk:"oauth2_client_credential_configuration"`
+}
+
+func (m pluginAuthConfigurationData) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.BasicAuthConfiguration.IsNull():
+		basicAuthConfigurationData := fwdiag.Must(m.BasicAuthConfiguration.ToPtr(ctx))
+
+		var r awstypes.PluginAuthConfigurationMemberBasicAuthConfiguration
+		diags.Append(fwflex.Expand(ctx, basicAuthConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.IdcAuthConfiguration.IsNull():
+		idcAuthConfigurationData := fwdiag.Must(m.IdcAuthConfiguration.ToPtr(ctx))
+
+		var r awstypes.PluginAuthConfigurationMemberIdcAuthConfiguration
+		diags.Append(fwflex.Expand(ctx, idcAuthConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.OAuth2ClientCredentialConfiguration.IsNull():
+		oauth2ClientCredentialConfigurationData := fwdiag.Must(m.OAuth2ClientCredentialConfiguration.ToPtr(ctx))
+
+		var r awstypes.PluginAuthConfigurationMemberOAuth2ClientCredentialConfiguration
+		diags.Append(fwflex.Expand(ctx, oauth2ClientCredentialConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return &awstypes.PluginAuthConfigurationMemberNoAuthConfiguration{}, diags
+}
+
+func (m *pluginAuthConfigurationData) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	m.BasicAuthConfiguration = fwtypes.NewListNestedObjectValueOfNull[basicAuthConfigurationData](ctx)
+	m.IdcAuthConfiguration = fwtypes.NewListNestedObjectValueOfNull[idcAuthConfigurationData](ctx)
+	m.OAuth2ClientCredentialConfiguration = fwtypes.NewListNestedObjectValueOfNull[oauth2ClientCredentialConfigurationData](ctx)
+
+	switch t := v.(type) {
+	case awstypes.PluginAuthConfigurationMemberBasicAuthConfiguration:
+		var data basicAuthConfigurationData
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.BasicAuthConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.PluginAuthConfigurationMemberIdcAuthConfiguration:
+		var data idcAuthConfigurationData
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.IdcAuthConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.PluginAuthConfigurationMemberOAuth2ClientCredentialConfiguration:
+		var data oauth2ClientCredentialConfigurationData
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.OAuth2ClientCredentialConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+	}
+
+	return diags
+}
+
+type basicAuthConfigurationData struct {
+	RoleArn   fwtypes.ARN `tfsdk:"role_arn"`
+	SecretArn fwtypes.ARN `tfsdk:"secret_arn"`
+}
+
+type idcAuthConfigurationData struct {
+	IdcApplicationArn fwtypes.ARN `tfsdk:"idc_application_arn"`
+	RoleArn           fwtypes.ARN `tfsdk:"role_arn"`
+}
+
+type oauth2ClientCredentialConfigurationData struct {
+	AuthorizationUrl types.String `tfsdk:"authorization_url"`
+	RoleArn          fwtypes.ARN  `tfsdk:"role_arn"`
+	SecretArn        fwtypes.ARN  `tfsdk:"secret_arn"`
+	TokenUrl         types.String `tfsdk:"token_url"`
+}
+
+type customPluginConfigurationData struct {
+	ApiSchema     fwtypes.ListNestedObjectValueOf[apiSchemaData] `tfsdk:"api_schema"`
+	ApiSchemaType fwtypes.StringEnum[awstypes.APISchemaType]     `tfsdk:"api_schema_type"`
+	Description   types.String                                   `tfsdk:"description"`
+}
+
+var (
+	_ fwflex.Expander  = apiSchemaData{}
+	_ fwflex.Flattener = &apiSchemaData{}
+)
+
+type apiSchemaData struct {
+	Payload types.String                            `tfsdk:"payload"`
+	S3      fwtypes.ListNestedObjectValueOf[s3Data] `tfsdk:"s3"`
+}
+
+func (m apiSchemaData) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Payload.IsNull():
+		return &awstypes.APISchemaMemberPayload{
+			Value: m.Payload.ValueString(),
+		}, diags
+
+	case !m.S3.IsNull():
+		s3Data := fwdiag.Must(m.S3.ToPtr(ctx))
+
+		var r awstypes.APISchemaMemberS3
+		diags.Append(fwflex.Expand(ctx, s3Data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *apiSchemaData) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	m.Payload = types.StringNull()
+	m.S3 = fwtypes.NewListNestedObjectValueOfNull[s3Data](ctx)
+
+	switch t := v.(type) {
+	case awstypes.APISchemaMemberPayload:
+		m.Payload = types.StringValue(t.Value)
+
+	case awstypes.APISchemaMemberS3:
+		var data s3Data
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.S3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+	}
+
+	return diags
+}
+
+type s3Data struct {
+	Bucket types.String `tfsdk:"bucket"`
+	Key    types.String `tfsdk:"key"`
+}
@@ -0,0 +1,455 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_qbusiness_retriever", name="Retriever")
+// @Tags(identifierAttribute="arn")
+func newResourceRetriever(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceRetriever{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameRetriever = "Retriever"
+
+	retrieverResourceIDPartCount = 2
+)
+
+type resourceRetriever struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceRetriever) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	indexIDAttribute := schema.StringAttribute{
+		Description: "Identifier of the index.",
+		Required:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Description: "Identifier of the Amazon Q application associated with the retriever.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDisplayName: schema.StringAttribute{
+				Description: "The display name of the retriever.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1000),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^\P{C}*$`), "must not contain control characters"),
+				},
+			},
+			"iam_service_role_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of an IAM role used by Amazon Q to access the basic authentication credentials stored in a Secrets Manager secret.",
+				Optional:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"retriever_id": schema.StringAttribute{
+				Description: "Identifier of the retriever.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.RetrieverType](),
+				Description: "The type of retriever.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrConfiguration: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[retrieverConfigurationData](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"kendra_index_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[kendraIndexConfigurationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("kendra_index_configuration"),
+									path.MatchRelative().AtParent().AtName("native_index_configuration"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"index_id": indexIDAttribute,
+								},
+							},
+						},
+						"native_index_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[nativeIndexConfigurationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"index_id": indexIDAttribute,
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceRetriever) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resourceRetrieverData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.CreateRetrieverInput{}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.Tags = getTagsIn(ctx)
+	input.ClientToken = aws.String(id.UniqueId())
+
+	out, err := conn.CreateRetriever(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionCreating, ResNameRetriever, data.DisplayName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	appID, retrieverID := data.ApplicationId.ValueString(), aws.ToString(out.RetrieverId)
+	id, _ := intflex.FlattenResourceId([]string{appID, retrieverID}, retrieverResourceIDPartCount, false)
+	resp.State.SetAttribute(ctx, path.Root(names.AttrID), id)
+
+	findOut, err := waitRetrieverActive(ctx, conn, appID, retrieverID, r.CreateTimeout(ctx, data.Timeouts))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForCreation, ResNameRetriever, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set unknown values
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, findOut, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceRetriever) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resourceRetrieverData
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	parts, err := intflex.ExpandResourceId(data.ID.ValueString(), retrieverResourceIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionReading, ResNameRetriever, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	out, err := findRetrieverByTwoPartKey(ctx, conn, parts[0], parts[1])
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionReading, ResNameRetriever, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceRetriever) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan resourceRetrieverData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Configuration.Equal(plan.Configuration) ||
+		!state.DisplayName.Equal(plan.DisplayName) ||
+		!state.RoleArn.Equal(plan.RoleArn) {
+		conn := r.Meta().QBusinessClient(ctx)
+
+		input := &qbusiness.UpdateRetrieverInput{}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, plan, input)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateRetriever(ctx, input)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionUpdating, ResNameRetriever, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		if _, err := waitRetrieverActive(ctx, conn, plan.ApplicationId.ValueString(), plan.RetrieverId.ValueString(), r.UpdateTimeout(ctx, plan.Timeouts)); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForUpdate, ResNameRetriever, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceRetriever) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resourceRetrieverData
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.DeleteRetrieverInput{
+		ApplicationId: data.ApplicationId.ValueStringPointer(),
+		RetrieverId:   data.RetrieverId.ValueStringPointer(),
+	}
+
+	if _, err := conn.DeleteRetriever(ctx, input); err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionDeleting, ResNameRetriever, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func findRetrieverByTwoPartKey(ctx context.Context, conn *qbusiness.Client, applicationID, retrieverID string) (*qbusiness.GetRetrieverOutput, error) {
+	input := &qbusiness.GetRetrieverInput{
+		ApplicationId: aws.String(applicationID),
+		RetrieverId:   aws.String(retrieverID),
+	}
+
+	output, err := conn.GetRetriever(ctx, input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusRetriever(ctx context.Context, conn *qbusiness.Client, applicationID, retrieverID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findRetrieverByTwoPartKey(ctx, conn, applicationID, retrieverID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitRetrieverActive(ctx context.Context, conn *qbusiness.Client, applicationID, retrieverID string, timeout time.Duration) (*qbusiness.GetRetrieverOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.RetrieverStatusCreating),
+		Target:     enum.Slice(awstypes.RetrieverStatusActive),
+		Refresh:    statusRetriever(ctx, conn, applicationID, retrieverID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetRetrieverOutput); ok {
+		return output, err
+	}
+	return nil, err
+}
+
+type resourceRetrieverData struct {
+	ApplicationId types.String                                                `tfsdk:"application_id"`
+	Configuration fwtypes.ListNestedObjectValueOf[retrieverConfigurationData] `tfsdk:"configuration"`
+	DisplayName   types.String                                                `tfsdk:"display_name"`
+	ID            types.String                                                `tfsdk:"id"`
+	RetrieverArn  types.String                                                `tfsdk:"arn"`
+	RetrieverId   types.String                                                `tfsdk:"retriever_id"`
+	RoleArn       fwtypes.ARN                                                 `tfsdk:"iam_service_role_arn"`
+	Tags          tftags.Map                                                  `tfsdk:"tags"`
+	TagsAll       tftags.Map                                                  `tfsdk:"tags_all"`
+	Timeouts      timeouts.Value                                              `tfsdk:"timeouts"`
+	Type          fwtypes.StringEnum[awstypes.RetrieverType]                  `tfsdk:"type"`
+}
+
+var (
+	_ fwflex.Expander  = retrieverConfigurationData{}
+	_ fwflex.Flattener = &retrieverConfigurationData{}
+)
+
+type retrieverConfigurationData struct {
+	KendraIndexConfiguration fwtypes.ListNestedObjectValueOf[kendraIndexConfigurationData] `tfsdk:"kendra_index_configuration"`
+	NativeIndexConfiguration fwtypes.ListNestedObjectValueOf[nativeIndexConfigurationData] `tfsdk:"native_index_configuration"`
+}
+
+func (m retrieverConfigurationData) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.KendraIndexConfiguration.IsNull():
+		kendraIndexConfigurationData := fwdiag.Must(m.KendraIndexConfiguration.ToPtr(ctx))
+
+		var r awstypes.RetrieverConfigurationMemberKendraIndexConfiguration
+		diags.Append(fwflex.Expand(ctx, kendraIndexConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.NativeIndexConfiguration.IsNull():
+		nativeIndexConfigurationData := fwdiag.Must(m.NativeIndexConfiguration.ToPtr(ctx))
+
+		var r awstypes.RetrieverConfigurationMemberNativeIndexConfiguration
+		diags.Append(fwflex.Expand(ctx, nativeIndexConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *retrieverConfigurationData) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	m.KendraIndexConfiguration = fwtypes.NewListNestedObjectValueOfNull[kendraIndexConfigurationData](ctx)
+	m.NativeIndexConfiguration = fwtypes.NewListNestedObjectValueOfNull[nativeIndexConfigurationData](ctx)
+
+	switch t := v.(type) {
+	case awstypes.RetrieverConfigurationMemberKendraIndexConfiguration:
+		var data kendraIndexConfigurationData
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.KendraIndexConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.RetrieverConfigurationMemberNativeIndexConfiguration:
+		var data nativeIndexConfigurationData
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.NativeIndexConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+	}
+
+	return diags
+}
+
+type kendraIndexConfigurationData struct {
+	IndexId types.String `tfsdk:"index_id"`
+}
+
+type nativeIndexConfigurationData struct {
+	IndexId types.String `tfsdk:"index_id"`
+}
@@ -24,6 +24,61 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceApplication,
 			TypeName: "aws_qbusiness_application",
 			Name:     "Application",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newResourceDataAccessor,
+			TypeName: "aws_qbusiness_data_accessor",
+			Name:     "Data Accessor",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newResourceDataSource,
+			TypeName: "aws_qbusiness_data_source",
+			Name:     "Data Source",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newResourceIndex,
+			TypeName: "aws_qbusiness_index",
+			Name:     "Index",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newResourcePlugin,
+			TypeName: "aws_qbusiness_plugin",
+			Name:     "Plugin",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newResourceRetriever,
+			TypeName: "aws_qbusiness_retriever",
+			Name:     "Retriever",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newResourceWebExperience,
+			TypeName: "aws_qbusiness_web_experience",
+			Name:     "Web Experience",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
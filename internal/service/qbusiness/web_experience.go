@@ -0,0 +1,535 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_qbusiness_web_experience", name="Web Experience")
+// @Tags(identifierAttribute="arn")
+func newResourceWebExperience(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceWebExperience{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameWebExperience = "Web Experience"
+
+	webExperienceResourceIDPartCount = 2
+)
+
+type resourceWebExperience struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceWebExperience) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Description: "Identifier of the Amazon Q application attached to the web experience.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"default_endpoint": schema.StringAttribute{
+				Description: "The endpoint of your Amazon Q web experience.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"iam_service_role_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of the service role attached to your web experience.",
+				Optional:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"origins": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Description: "The allowed origins, i.e. the website domains, that can embed the Amazon Q web experience.",
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(10),
+				},
+			},
+			"sample_prompts_control_mode": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.WebExperienceSamplePromptsControlMode](),
+				Description: "Determines whether sample prompts are enabled in the web experience for an end user.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subtitle": schema.StringAttribute{
+				Description: "A subtitle to personalize your Amazon Q web experience.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^\P{C}*$`), "must not contain control characters"),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"title": schema.StringAttribute{
+				Description: "The title for your Amazon Q web experience.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^\P{C}*$`), "must not contain control characters"),
+				},
+			},
+			"web_experience_id": schema.StringAttribute{
+				Description: "Identifier of the web experience.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"welcome_message": schema.StringAttribute{
+				Description: "The customized welcome message for end users of an Amazon Q web experience.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(300),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"identity_provider_configuration": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[identityProviderConfigurationData](ctx),
+				Description: "Information about the identity provider (IdP) used to authenticate end users of an Amazon Q web experience.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"open_id_connect_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[openIDConnectProviderConfigurationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("open_id_connect_configuration"),
+									path.MatchRelative().AtParent().AtName("saml_configuration"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"secrets_arn": schema.StringAttribute{
+										CustomType:  fwtypes.ARNType,
+										Description: "The ARN of a Secrets Manager secret containing the OIDC client secret.",
+										Required:    true,
+									},
+									"secrets_role": schema.StringAttribute{
+										CustomType:  fwtypes.ARNType,
+										Description: "The ARN of an IAM role used by Amazon Q to access the secret.",
+										Required:    true,
+									},
+								},
+							},
+						},
+						"saml_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[samlProviderConfigurationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"authentication_url": schema.StringAttribute{
+										Description: "The URL where Amazon Q end users will be redirected for authentication.",
+										Required:    true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 1284),
+											stringvalidator.RegexMatches(regexache.MustCompile(`^https://.*$`), "must be an HTTPS URL"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceWebExperience) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resourceWebExperienceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.CreateWebExperienceInput{}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.Tags = getTagsIn(ctx)
+	input.ClientToken = aws.String(id.UniqueId())
+
+	out, err := conn.CreateWebExperience(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionCreating, ResNameWebExperience, data.Title.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	appID, webExperienceID := data.ApplicationId.ValueString(), aws.ToString(out.WebExperienceId)
+	id, _ := intflex.FlattenResourceId([]string{appID, webExperienceID}, webExperienceResourceIDPartCount, false)
+	resp.State.SetAttribute(ctx, path.Root(names.AttrID), id)
+
+	findOut, err := waitWebExperienceCreated(ctx, conn, appID, webExperienceID, r.CreateTimeout(ctx, data.Timeouts))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForCreation, ResNameWebExperience, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set unknown values
+	data.DefaultEndpoint = fwflex.StringToFramework(ctx, findOut.DefaultEndpoint)
+	data.ID = types.StringValue(id)
+	data.SamplePromptsControlMode = fwtypes.StringEnumValue(findOut.SamplePromptsControlMode)
+	data.WebExperienceArn = fwflex.StringToFramework(ctx, findOut.WebExperienceArn)
+	data.WebExperienceId = fwflex.StringToFramework(ctx, findOut.WebExperienceId)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceWebExperience) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resourceWebExperienceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	parts, err := intflex.ExpandResourceId(data.ID.ValueString(), webExperienceResourceIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionReading, ResNameWebExperience, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	out, err := findWebExperienceByTwoPartKey(ctx, conn, parts[0], parts[1])
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionReading, ResNameWebExperience, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceWebExperience) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan resourceWebExperienceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.IdentityProviderConfiguration.Equal(plan.IdentityProviderConfiguration) ||
+		!state.Origins.Equal(plan.Origins) ||
+		!state.RoleArn.Equal(plan.RoleArn) ||
+		!state.SamplePromptsControlMode.Equal(plan.SamplePromptsControlMode) ||
+		!state.Subtitle.Equal(plan.Subtitle) ||
+		!state.Title.Equal(plan.Title) ||
+		!state.WelcomeMessage.Equal(plan.WelcomeMessage) {
+		conn := r.Meta().QBusinessClient(ctx)
+
+		input := &qbusiness.UpdateWebExperienceInput{}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, plan, input)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateWebExperience(ctx, input)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionUpdating, ResNameWebExperience, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceWebExperience) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resourceWebExperienceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	appID, webExperienceID := data.ApplicationId.ValueString(), data.WebExperienceId.ValueString()
+	input := &qbusiness.DeleteWebExperienceInput{
+		ApplicationId:   aws.String(appID),
+		WebExperienceId: aws.String(webExperienceID),
+	}
+
+	if _, err := conn.DeleteWebExperience(ctx, input); err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionDeleting, ResNameWebExperience, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := waitWebExperienceDeleted(ctx, conn, appID, webExperienceID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForDeletion, ResNameWebExperience, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func findWebExperienceByTwoPartKey(ctx context.Context, conn *qbusiness.Client, applicationID, webExperienceID string) (*qbusiness.GetWebExperienceOutput, error) {
+	input := &qbusiness.GetWebExperienceInput{
+		ApplicationId:   aws.String(applicationID),
+		WebExperienceId: aws.String(webExperienceID),
+	}
+
+	output, err := conn.GetWebExperience(ctx, input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusWebExperience(ctx context.Context, conn *qbusiness.Client, applicationID, webExperienceID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findWebExperienceByTwoPartKey(ctx, conn, applicationID, webExperienceID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitWebExperienceCreated(ctx context.Context, conn *qbusiness.Client, applicationID, webExperienceID string, timeout time.Duration) (*qbusiness.GetWebExperienceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.WebExperienceStatusCreating),
+		Target:     enum.Slice(awstypes.WebExperienceStatusActive, awstypes.WebExperienceStatusPendingAuthConfig),
+		Refresh:    statusWebExperience(ctx, conn, applicationID, webExperienceID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetWebExperienceOutput); ok {
+		if output.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Error.ErrorMessage)))
+		}
+
+		return output, err
+	}
+	return nil, err
+}
+
+func waitWebExperienceDeleted(ctx context.Context, conn *qbusiness.Client, applicationID, webExperienceID string, timeout time.Duration) (*qbusiness.GetWebExperienceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.WebExperienceStatusActive, awstypes.WebExperienceStatusPendingAuthConfig, awstypes.WebExperienceStatusDeleting),
+		Target:     []string{},
+		Refresh:    statusWebExperience(ctx, conn, applicationID, webExperienceID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetWebExperienceOutput); ok {
+		if output.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Error.ErrorMessage)))
+		}
+
+		return output, err
+	}
+	return nil, err
+}
+
+type resourceWebExperienceData struct {
+	ApplicationId                 types.String                                                       `tfsdk:"application_id"`
+	DefaultEndpoint               types.String                                                       `tfsdk:"default_endpoint"`
+	ID                            types.String                                                       `tfsdk:"id"`
+	IdentityProviderConfiguration fwtypes.ListNestedObjectValueOf[identityProviderConfigurationData] `tfsdk:"identity_provider_configuration"`
+	Origins                       fwtypes.SetOfString                                                `tfsdk:"origins"`
+	RoleArn                       fwtypes.ARN                                                        `tfsdk:"iam_service_role_arn"`
+	SamplePromptsControlMode      fwtypes.StringEnum[awstypes.WebExperienceSamplePromptsControlMode] `tfsdk:"sample_prompts_control_mode"`
+	Subtitle                      types.String                                                       `tfsdk:"subtitle"`
+	Tags                          tftags.Map                                                         `tfsdk:"tags"`
+	TagsAll                       tftags.Map                                                         `tfsdk:"tags_all"`
+	Timeouts                      timeouts.Value                                                     `tfsdk:"timeouts"`
+	Title                         types.String                                                       `tfsdk:"title"`
+	WebExperienceArn              types.String                                                       `tfsdk:"arn"`
+	WebExperienceId               types.String                                                       `tfsdk:"web_experience_id"`
+	WelcomeMessage                types.String                                                       `tfsdk:"welcome_message"`
+}
+
+var (
+	_ fwflex.Expander  = identityProviderConfigurationData{}
+	_ fwflex.Flattener = &identityProviderConfigurationData{}
+)
+
+type identityProviderConfigurationData struct {
+	OpenIDConnectConfiguration fwtypes.ListNestedObjectValueOf[openIDConnectProviderConfigurationData] `tfsdk:"open_id_connect_configuration"`
+	SamlConfiguration          fwtypes.ListNestedObjectValueOf[samlProviderConfigurationData]          `tfsdk:"saml_configuration"`
+}
+
+func (m identityProviderConfigurationData) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.OpenIDConnectConfiguration.IsNull():
+		openIDConnectProviderConfigurationData := fwdiag.Must(m.OpenIDConnectConfiguration.ToPtr(ctx))
+
+		var r awstypes.IdentityProviderConfigurationMemberOpenIDConnectConfiguration
+		diags.Append(fwflex.Expand(ctx, openIDConnectProviderConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.SamlConfiguration.IsNull():
+		samlProviderConfigurationData := fwdiag.Must(m.SamlConfiguration.ToPtr(ctx))
+
+		var r awstypes.IdentityProviderConfigurationMemberSamlConfiguration
+		diags.Append(fwflex.Expand(ctx, samlProviderConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *identityProviderConfigurationData) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	m.OpenIDConnectConfiguration = fwtypes.NewListNestedObjectValueOfNull[openIDConnectProviderConfigurationData](ctx)
+	m.SamlConfiguration = fwtypes.NewListNestedObjectValueOfNull[samlProviderConfigurationData](ctx)
+
+	switch t := v.(type) {
+	case awstypes.IdentityProviderConfigurationMemberOpenIDConnectConfiguration:
+		var data openIDConnectProviderConfigurationData
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.OpenIDConnectConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.IdentityProviderConfigurationMemberSamlConfiguration:
+		var data samlProviderConfigurationData
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.SamlConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+	}
+
+	return diags
+}
+
+type openIDConnectProviderConfigurationData struct {
+	SecretsArn  fwtypes.ARN `tfsdk:"secrets_arn"`
+	SecretsRole fwtypes.ARN `tfsdk:"secrets_role"`
+}
+
+type samlProviderConfigurationData struct {
+	AuthenticationUrl types.String `tfsdk:"authentication_url"`
+}
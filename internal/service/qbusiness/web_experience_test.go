@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfqbusiness "github.com/hashicorp/terraform-provider-aws/internal/service/qbusiness"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQBusinessWebExperience_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var webExperience qbusiness.GetWebExperienceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_web_experience.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckApplication(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebExperienceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebExperienceConfig_basic(rName, "Welcome"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWebExperienceExists(ctx, resourceName, &webExperience),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_qbusiness_application.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "default_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "origins.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sample_prompts_control_mode", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "subtitle", rName),
+					resource.TestCheckResourceAttr(resourceName, "title", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "web_experience_id"),
+					resource.TestCheckResourceAttr(resourceName, "welcome_message", "Welcome"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWebExperienceConfig_basic(rName, "Welcome back"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWebExperienceExists(ctx, resourceName, &webExperience),
+					resource.TestCheckResourceAttr(resourceName, "welcome_message", "Welcome back"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQBusinessWebExperience_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var webExperience qbusiness.GetWebExperienceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_web_experience.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckApplication(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebExperienceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebExperienceConfig_basic(rName, "Welcome"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWebExperienceExists(ctx, resourceName, &webExperience),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfqbusiness.ResourceWebExperience, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckWebExperienceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QBusinessClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_qbusiness_web_experience" {
+				continue
+			}
+
+			_, err := tfqbusiness.FindWebExperienceByTwoPartKey(ctx, conn, rs.Primary.Attributes["application_id"], rs.Primary.Attributes["web_experience_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Amazon Q Web Experience %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckWebExperienceExists(ctx context.Context, n string, v *qbusiness.GetWebExperienceOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QBusinessClient(ctx)
+
+		output, err := tfqbusiness.FindWebExperienceByTwoPartKey(ctx, conn, rs.Primary.Attributes["application_id"], rs.Primary.Attributes["web_experience_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccWebExperienceConfig_basic(rName, welcomeMessage string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_qbusiness_web_experience" "test" {
+  application_id              = aws_qbusiness_application.test.id
+  origins                     = ["https://example.com"]
+  sample_prompts_control_mode = "ENABLED"
+  subtitle                    = %[1]q
+  title                       = %[1]q
+  welcome_message             = %[2]q
+}
+`, rName, welcomeMessage))
+}
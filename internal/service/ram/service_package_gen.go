@@ -28,6 +28,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceResourceShare,
 			TypeName: "aws_ram_resource_share",
 			Name:     "Resource Share",
+			Region:   types.ResourceRegionDefault(),
 			Tags:     &types.ServicePackageResourceTags{},
 		},
 	}
@@ -39,16 +40,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourcePrincipalAssociation,
 			TypeName: "aws_ram_principal_association",
 			Name:     "Principal Association",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceResourceAssociation,
 			TypeName: "aws_ram_resource_association",
 			Name:     "Resource Association",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceResourceShare,
 			TypeName: "aws_ram_resource_share",
 			Name:     "Resource Share",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
@@ -57,11 +61,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceResourceShareAccepter,
 			TypeName: "aws_ram_resource_share_accepter",
 			Name:     "Resource Share Accepter",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceSharingWithOrganization,
 			TypeName: "aws_ram_sharing_with_organization",
 			Name:     "Sharing With Organization",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameIAMAuthToken = "Ephemeral Resource IAM Auth Token"
+
+	// iamAuthTokenExpiration is the fixed lifetime of an RDS IAM authentication token.
+	iamAuthTokenExpiration = 15 * time.Minute
+	// iamAuthTokenSigningName is the SigV4 signing name used for RDS IAM database authentication.
+	iamAuthTokenSigningName = "rds-db"
+	// emptyPayloadHash is the SHA-256 hash of an empty request body.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// @EphemeralResource(aws_rds_iam_auth_token, name="IAM Auth Token")
+func newEphemeralIAMAuthToken(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralIAMAuthToken{}, nil
+}
+
+type ephemeralIAMAuthToken struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralIAMAuthToken) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrEndpoint: schema.StringAttribute{
+				Computed: true,
+			},
+			"hostname": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrPort: schema.Int32Attribute{
+				Required: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65535),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrUsername: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralIAMAuthToken) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	data := epIAMAuthTokenData{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := net.JoinHostPort(data.Hostname.ValueString(), strconv.Itoa(int(data.Port.ValueInt32())))
+
+	token, err := buildIAMAuthToken(ctx, endpoint, e.Meta().Region(ctx), data.Username.ValueString(), e.Meta().CredentialsProvider(ctx))
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionOpening, ERNameIAMAuthToken, endpoint, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Endpoint = types.StringValue(endpoint)
+	data.Token = types.StringValue(token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+// buildIAMAuthToken returns a presigned "connect" request for the specified database endpoint and user.
+// See https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/UsingWithRDS.IAMDBAuth.Connecting.html.
+func buildIAMAuthToken(ctx context.Context, endpoint, region, dbUser string, credentialsProvider aws.CredentialsProvider) (string, error) {
+	credentials, err := credentialsProvider.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("retrieving credentials: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+endpoint+"/", nil)
+	if err != nil {
+		return "", err
+	}
+
+	values := request.URL.Query()
+	values.Set("Action", "connect")
+	values.Set("DBUser", dbUser)
+	values.Set("X-Amz-Expires", strconv.Itoa(int(iamAuthTokenExpiration.Seconds())))
+	request.URL.RawQuery = values.Encode()
+
+	signedURI, _, err := v4.NewSigner().PresignHTTP(ctx, credentials, request, emptyPayloadHash, iamAuthTokenSigningName, region, time.Now().UTC())
+	if err != nil {
+		return "", fmt.Errorf("presigning request: %w", err)
+	}
+
+	return strings.TrimPrefix(signedURI, "https://"), nil
+}
+
+type epIAMAuthTokenData struct {
+	Endpoint types.String `tfsdk:"endpoint"`
+	Hostname types.String `tfsdk:"hostname"`
+	Port     types.Int32  `tfsdk:"port"`
+	Token    types.String `tfsdk:"token"`
+	Username types.String `tfsdk:"username"`
+}
@@ -30,6 +30,7 @@ import (
 )
 
 // @SDKResource("aws_db_parameter_group", name="DB Parameter Group")
+// @IdentityAttribute("name")
 // @Tags(identifierAttribute="arn")
 // @Testing(tagsTest=false)
 func resourceParameterGroup() *schema.Resource {
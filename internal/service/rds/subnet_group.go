@@ -26,6 +26,7 @@ import (
 )
 
 // @SDKResource("aws_db_subnet_group", name="DB Subnet Group")
+// @IdentityAttribute("name")
 // @Tags(identifierAttribute="arn")
 // @Testing(tagsTest=false)
 func resourceSubnetGroup() *schema.Resource {
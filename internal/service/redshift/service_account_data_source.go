@@ -49,6 +49,7 @@ var ServiceAccountPerRegionMap = map[string]string{
 }
 
 // @SDKDataSource("aws_redshift_service_account", name="Service Account")
+// @Region(overrideEnabled=false)
 func dataSourceServiceAccount() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceServiceAccountRead,
@@ -32,6 +32,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceStatement,
 			TypeName: "aws_redshiftdata_statement",
 			Name:     "Statement",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -24,6 +24,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newCustomDomainAssociationResource,
 			TypeName: "aws_redshiftserverless_custom_domain_association",
 			Name:     "Custom Domain Association",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -34,16 +35,19 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceCredentials,
 			TypeName: "aws_redshiftserverless_credentials",
 			Name:     "Credentials",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceNamespace,
 			TypeName: "aws_redshiftserverless_namespace",
 			Name:     "Namespace",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceWorkgroup,
 			TypeName: "aws_redshiftserverless_workgroup",
 			Name:     "Workgroup",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -54,11 +58,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceEndpointAccess,
 			TypeName: "aws_redshiftserverless_endpoint_access",
 			Name:     "Endpoint Access",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceNamespace,
 			TypeName: "aws_redshiftserverless_namespace",
 			Name:     "Namespace",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -67,21 +73,25 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceResourcePolicy,
 			TypeName: "aws_redshiftserverless_resource_policy",
 			Name:     "Resource Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceSnapshot,
 			TypeName: "aws_redshiftserverless_snapshot",
 			Name:     "Snapshot",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceUsageLimit,
 			TypeName: "aws_redshiftserverless_usage_limit",
 			Name:     "Usage Limit",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceWorkgroup,
 			TypeName: "aws_redshiftserverless_workgroup",
 			Name:     "Workgroup",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
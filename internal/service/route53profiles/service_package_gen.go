@@ -20,6 +20,7 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceProfiles,
 			TypeName: "aws_route53profiles_profiles",
 			Name:     "Profiles",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -30,6 +31,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceAssociation,
 			TypeName: "aws_route53profiles_association",
 			Name:     "Association",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -38,6 +40,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceProfile,
 			TypeName: "aws_route53profiles_profile",
 			Name:     "Profile",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -46,6 +49,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceResourceAssociation,
 			TypeName: "aws_route53profiles_resource_association",
 			Name:     "ResourceAssociation",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -49,6 +49,8 @@ const (
 )
 
 // @SDKResource("aws_s3_bucket", name="Bucket")
+// @Region(overrideEnabled=false)
+// @IdentityAttribute("bucket")
 // @Tags(identifierAttribute="bucket", resourceType="Bucket")
 // @Testing(importIgnore="force_destroy")
 func resourceBucket() *schema.Resource {
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_s3_bucket", name="Bucket")
+func newBucketResourceAsListResource() itypes.ListResourceForSDK {
+	return &bucketListResource{}
+}
+
+type bucketListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *bucketListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{}
+}
+
+func (l *bucketListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.S3Client(ctx)
+
+	// Only general purpose buckets in the in-effect Region are listed.
+	input := s3.ListBucketsInput{
+		BucketRegion: aws.String(awsClient.Region(ctx)),
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		pages := s3.NewListBucketsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				var result list.ListResult
+				result.Diagnostics.AddError("Listing S3 Buckets", fmt.Sprintf("listing S3 Buckets: %s", err))
+				yield(result)
+				return
+			}
+
+			for _, v := range page.Buckets {
+				bucket := aws.ToString(v.Name)
+
+				result := request.NewListResult(ctx)
+				rd := l.ResourceData()
+				rd.SetId(bucket)
+				if err := rd.Set(names.AttrBucket, bucket); err != nil {
+					result.Diagnostics.AddError("Setting "+names.AttrBucket, err.Error())
+					yield(result)
+					return
+				}
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+				if !result.Diagnostics.HasError() && rd.Id() == "" {
+					continue
+				}
+				result.DisplayName = bucket
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
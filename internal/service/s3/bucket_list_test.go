@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3Bucket_List_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfig_basic(rName),
+			},
+			{
+				Query:  true,
+				Config: testAccBucketListQuery_basic(false),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("aws_s3_bucket.test", 1),
+					querycheck.ExpectIdentity("aws_s3_bucket.test", map[string]knownvalue.Check{
+						names.AttrAccountID: knownvalue.StringExact(acctest.AccountID(ctx)),
+						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
+						names.AttrBucket:    knownvalue.StringExact(rName),
+					}),
+					querycheck.ExpectResourceDisplayName("aws_s3_bucket.test", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						names.AttrAccountID: knownvalue.StringExact(acctest.AccountID(ctx)),
+						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
+						names.AttrBucket:    knownvalue.StringExact(rName),
+					}), knownvalue.StringExact(rName)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccS3Bucket_List_includeResource(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfig_basic(rName),
+			},
+			{
+				Query:  true,
+				Config: testAccBucketListQuery_basic(true),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("aws_s3_bucket.test", 1),
+					querycheck.ExpectResourceKnownValues("aws_s3_bucket.test", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						names.AttrAccountID: knownvalue.StringExact(acctest.AccountID(ctx)),
+						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
+						names.AttrBucket:    knownvalue.StringExact(rName),
+					}), []querycheck.KnownValueCheck{
+						{Path: tfjsonpath.New(names.AttrBucket), KnownValue: knownvalue.StringExact(rName)},
+						{Path: tfjsonpath.New(names.AttrRegion), KnownValue: knownvalue.StringExact(acctest.Region())},
+						{Path: tfjsonpath.New(names.AttrARN), KnownValue: knownvalue.NotNull()},
+						{Path: tfjsonpath.New("bucket_domain_name"), KnownValue: knownvalue.NotNull()},
+					}),
+				},
+			},
+		},
+	})
+}
+
+func testAccBucketListQuery_basic(includeResource bool) string {
+	return fmt.Sprintf(`
+provider "aws" {}
+
+list "aws_s3_bucket" "test" {
+  provider         = aws
+  include_resource = %[1]t
+}
+`, includeResource)
+}
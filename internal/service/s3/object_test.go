@@ -113,6 +113,7 @@ func TestSDKv1CompatibleCleanKey(t *testing.T) {
 
 func TestAccS3Object_basic(t *testing.T) {
 	ctx := acctest.Context(t)
+	acctest.EmulatorCompatible(t)
 	var obj s3.GetObjectOutput
 	resourceName := "aws_s3_object.object"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -175,6 +176,7 @@ func TestAccS3Object_basic(t *testing.T) {
 
 func TestAccS3Object_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
+	acctest.EmulatorCompatible(t)
 	var obj s3.GetObjectOutput
 	resourceName := "aws_s3_object.object"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -20,6 +20,7 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDirectoryBucketsDataSource,
 			TypeName: "aws_s3_directory_buckets",
 			Name:     "Directory Buckets",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -30,11 +31,13 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceBucketLifecycleConfiguration,
 			TypeName: "aws_s3_bucket_lifecycle_configuration",
 			Name:     "Bucket Lifecycle Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newDirectoryBucketResource,
 			TypeName: "aws_s3_directory_bucket",
 			Name:     "Directory Bucket",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -45,6 +48,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceCanonicalUserID,
 			TypeName: "aws_canonical_user_id",
 			Name:     "Canonical User ID",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceBucket,
@@ -55,6 +59,7 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceBucketObject,
 			TypeName: "aws_s3_bucket_object",
 			Name:     "Bucket Object",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 				ResourceType:        "BucketObject",
@@ -64,16 +69,19 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceBucketObjects,
 			TypeName: "aws_s3_bucket_objects",
 			Name:     "Bucket Objects",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceBucketPolicy,
 			TypeName: "aws_s3_bucket_policy",
 			Name:     "Bucket Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceObject,
 			TypeName: "aws_s3_object",
 			Name:     "Object",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 				ResourceType:        "Object",
@@ -83,6 +91,18 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceObjects,
 			TypeName: "aws_s3_objects",
 			Name:     "Objects",
+			Region:   types.ResourceRegionDefault(),
+		},
+	}
+}
+
+func (p *servicePackage) SDKListResources(ctx context.Context) []*types.ServicePackageSDKListResource {
+	return []*types.ServicePackageSDKListResource{
+		{
+			Factory:  newBucketResourceAsListResource,
+			TypeName: "aws_s3_bucket",
+			Name:     "Bucket",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -93,6 +113,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceBucket,
 			TypeName: "aws_s3_bucket",
 			Name:     "Bucket",
+			Identity: types.RegionalSingleParameterIdentity(names.AttrBucket),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrBucket,
 				ResourceType:        "Bucket",
@@ -102,51 +123,61 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceBucketAccelerateConfiguration,
 			TypeName: "aws_s3_bucket_accelerate_configuration",
 			Name:     "Bucket Accelerate Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketACL,
 			TypeName: "aws_s3_bucket_acl",
 			Name:     "Bucket ACL",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketAnalyticsConfiguration,
 			TypeName: "aws_s3_bucket_analytics_configuration",
 			Name:     "Bucket Analytics Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketCorsConfiguration,
 			TypeName: "aws_s3_bucket_cors_configuration",
 			Name:     "Bucket CORS Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketIntelligentTieringConfiguration,
 			TypeName: "aws_s3_bucket_intelligent_tiering_configuration",
 			Name:     "Bucket Intelligent-Tiering Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketInventory,
 			TypeName: "aws_s3_bucket_inventory",
 			Name:     "Bucket Inventory",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketLogging,
 			TypeName: "aws_s3_bucket_logging",
 			Name:     "Bucket Logging",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketMetric,
 			TypeName: "aws_s3_bucket_metric",
 			Name:     "Bucket Metric",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketNotification,
 			TypeName: "aws_s3_bucket_notification",
 			Name:     "Bucket Notification",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketObject,
 			TypeName: "aws_s3_bucket_object",
 			Name:     "Bucket Object",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 				ResourceType:        "BucketObject",
@@ -156,51 +187,61 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceBucketObjectLockConfiguration,
 			TypeName: "aws_s3_bucket_object_lock_configuration",
 			Name:     "Bucket Object Lock Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketOwnershipControls,
 			TypeName: "aws_s3_bucket_ownership_controls",
 			Name:     "Bucket Ownership Controls",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketPolicy,
 			TypeName: "aws_s3_bucket_policy",
 			Name:     "Bucket Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketPublicAccessBlock,
 			TypeName: "aws_s3_bucket_public_access_block",
 			Name:     "Bucket Public Access Block",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketReplicationConfiguration,
 			TypeName: "aws_s3_bucket_replication_configuration",
 			Name:     "Bucket Replication Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketRequestPaymentConfiguration,
 			TypeName: "aws_s3_bucket_request_payment_configuration",
 			Name:     "Bucket Request Payment Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketServerSideEncryptionConfiguration,
 			TypeName: "aws_s3_bucket_server_side_encryption_configuration",
 			Name:     "Bucket Server-side Encryption Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketVersioning,
 			TypeName: "aws_s3_bucket_versioning",
 			Name:     "Bucket Versioning",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceBucketWebsiteConfiguration,
 			TypeName: "aws_s3_bucket_website_configuration",
 			Name:     "Bucket Website Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceObject,
 			TypeName: "aws_s3_object",
 			Name:     "Object",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 				ResourceType:        "Object",
@@ -210,6 +251,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceObjectCopy,
 			TypeName: "aws_s3_object_copy",
 			Name:     "Object Copy",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 				ResourceType:        "ObjectCopy",
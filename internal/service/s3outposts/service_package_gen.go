@@ -32,6 +32,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceEndpoint,
 			TypeName: "aws_s3outposts_endpoint",
 			Name:     "Endpoint",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
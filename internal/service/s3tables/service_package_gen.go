@@ -24,26 +24,31 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceNamespace,
 			TypeName: "aws_s3tables_namespace",
 			Name:     "Namespace",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceTable,
 			TypeName: "aws_s3tables_table",
 			Name:     "Table",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceTableBucket,
 			TypeName: "aws_s3tables_table_bucket",
 			Name:     "Table Bucket",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceTableBucketPolicy,
 			TypeName: "aws_s3tables_table_bucket_policy",
 			Name:     "Table Bucket Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceTablePolicy,
 			TypeName: "aws_s3tables_table_policy",
 			Name:     "Table Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
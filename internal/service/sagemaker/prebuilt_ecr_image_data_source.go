@@ -720,6 +720,7 @@ var prebuiltECRImageIDByRegion_modelMonitor = map[string]string{
 }
 
 // @SDKDataSource("aws_sagemaker_prebuilt_ecr_image", name="Prebuilt ECR Image")
+// @Region(overrideEnabled=false)
 func dataSourcePrebuiltECRImage() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourcePrebuiltECRImageRead,
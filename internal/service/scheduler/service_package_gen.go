@@ -32,11 +32,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceSchedule,
 			TypeName: "aws_scheduler_schedule",
 			Name:     "Schedule",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceScheduleGroup,
 			TypeName: "aws_scheduler_schedule_group",
 			Name:     "Schedule Group",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
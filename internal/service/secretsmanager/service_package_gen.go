@@ -20,11 +20,13 @@ func (p *servicePackage) EphemeralResources(ctx context.Context) []*types.Servic
 			Factory:  newEphemeralRandomPassword,
 			TypeName: "aws_secretsmanager_random_password",
 			Name:     "Random Password",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newEphemeralSecretVersion,
 			TypeName: "aws_secretsmanager_secret_version",
 			Name:     "Secret Version",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -35,6 +37,7 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceSecretVersions,
 			TypeName: "aws_secretsmanager_secret_versions",
 			Name:     "Secret Versions",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -49,11 +52,13 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceRandomPassword,
 			TypeName: "aws_secretsmanager_random_password",
 			Name:     "Random Password",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceSecret,
 			TypeName: "aws_secretsmanager_secret",
 			Name:     "Secret",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -62,16 +67,19 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceSecretRotation,
 			TypeName: "aws_secretsmanager_secret_rotation",
 			Name:     "Secret Rotation",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceSecretVersion,
 			TypeName: "aws_secretsmanager_secret_version",
 			Name:     "Secret Version",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceSecrets,
 			TypeName: "aws_secretsmanager_secrets",
 			Name:     "Secrets",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -82,6 +90,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceSecret,
 			TypeName: "aws_secretsmanager_secret",
 			Name:     "Secret",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -90,16 +99,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceSecretPolicy,
 			TypeName: "aws_secretsmanager_secret_policy",
 			Name:     "Secret Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceSecretRotation,
 			TypeName: "aws_secretsmanager_secret_rotation",
 			Name:     "Secret Rotation",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceSecretVersion,
 			TypeName: "aws_secretsmanager_secret_version",
 			Name:     "Secret Version",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -24,16 +24,19 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newAWSLogSourceResource,
 			TypeName: "aws_securitylake_aws_log_source",
 			Name:     "AWS Log Source",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newCustomLogSourceResource,
 			TypeName: "aws_securitylake_custom_log_source",
 			Name:     "Custom Log Source",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newDataLakeResource,
 			TypeName: "aws_securitylake_data_lake",
 			Name:     "Data Lake",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -42,6 +45,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newSubscriberResource,
 			TypeName: "aws_securitylake_subscriber",
 			Name:     "Subscriber",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -50,6 +54,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newSubscriberNotificationResource,
 			TypeName: "aws_securitylake_subscriber_notification",
 			Name:     "Subscriber Notification",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -28,6 +28,7 @@ import (
 )
 
 // @FrameworkResource("aws_servicequotas_template", name="Template")
+// @Region(overrideEnabled=false)
 func newResourceTemplate(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceTemplate{}, nil
 }
@@ -28,21 +28,25 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceActivity,
 			TypeName: "aws_sfn_activity",
 			Name:     "Activity",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceAlias,
 			TypeName: "aws_sfn_alias",
 			Name:     "Alias",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceStateMachine,
 			TypeName: "aws_sfn_state_machine",
 			Name:     "State Machine",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceStateMachineVersions,
 			TypeName: "aws_sfn_state_machine_versions",
 			Name:     "State Machine Versions",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -53,6 +57,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceActivity,
 			TypeName: "aws_sfn_activity",
 			Name:     "Activity",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
@@ -61,11 +66,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceAlias,
 			TypeName: "aws_sfn_alias",
 			Name:     "Alias",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceStateMachine,
 			TypeName: "aws_sfn_state_machine",
 			Name:     "State Machine",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
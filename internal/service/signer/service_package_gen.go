@@ -28,11 +28,13 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceSigningJob,
 			TypeName: "aws_signer_signing_job",
 			Name:     "Signing Job",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  DataSourceSigningProfile,
 			TypeName: "aws_signer_signing_profile",
 			Name:     "Signing Profile",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -43,11 +45,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceSigningJob,
 			TypeName: "aws_signer_signing_job",
 			Name:     "Signing Job",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceSigningProfile,
 			TypeName: "aws_signer_signing_profile",
 			Name:     "Signing Profile",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -56,6 +60,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceSigningProfilePermission,
 			TypeName: "aws_signer_signing_profile_permission",
 			Name:     "Signing Profile Permission",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
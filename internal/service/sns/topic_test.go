@@ -37,6 +37,7 @@ func testAccErrorCheckSkip(t *testing.T) resource.ErrorCheckFunc {
 
 func TestAccSNSTopic_basic(t *testing.T) {
 	ctx := acctest.Context(t)
+	acctest.EmulatorCompatible(t)
 	var attributes map[string]string
 	resourceName := "aws_sns_topic.test"
 
@@ -96,6 +97,7 @@ func TestAccSNSTopic_basic(t *testing.T) {
 
 func TestAccSNSTopic_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
+	acctest.EmulatorCompatible(t)
 	var attributes map[string]string
 	resourceName := "aws_sns_topic.test"
 
@@ -94,6 +94,7 @@ func TestQueueNameFromURL(t *testing.T) {
 
 func TestAccSQSQueue_basic(t *testing.T) {
 	ctx := acctest.Context(t)
+	acctest.EmulatorCompatible(t)
 	var queueAttributes map[types.QueueAttributeName]string
 	resourceName := "aws_sqs_queue.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -143,6 +144,7 @@ func TestAccSQSQueue_basic(t *testing.T) {
 
 func TestAccSQSQueue_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
+	acctest.EmulatorCompatible(t)
 	var queueAttributes map[types.QueueAttributeName]string
 	resourceName := "aws_sqs_queue.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -20,26 +20,31 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceApplication,
 			TypeName: "aws_ssoadmin_application",
 			Name:     "Application",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newDataSourceApplicationAssignments,
 			TypeName: "aws_ssoadmin_application_assignments",
 			Name:     "Application Assignments",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newDataSourceApplicationProviders,
 			TypeName: "aws_ssoadmin_application_providers",
 			Name:     "Application Providers",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newPermissionSetsDataSource,
 			TypeName: "aws_ssoadmin_permission_sets",
 			Name:     "Permission Sets",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newDataSourcePrincipalApplicationAssignments,
 			TypeName: "aws_ssoadmin_principal_application_assignments",
 			Name:     "Principal Application Assignments",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -50,27 +55,32 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceApplication,
 			TypeName: "aws_ssoadmin_application",
 			Name:     "Application",
+			Region:   types.ResourceRegionDefault(),
 			Tags:     &types.ServicePackageResourceTags{},
 		},
 		{
 			Factory:  newResourceApplicationAccessScope,
 			TypeName: "aws_ssoadmin_application_access_scope",
 			Name:     "Application Access Scope",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceApplicationAssignment,
 			TypeName: "aws_ssoadmin_application_assignment",
 			Name:     "Application Assignment",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceApplicationAssignmentConfiguration,
 			TypeName: "aws_ssoadmin_application_assignment_configuration",
 			Name:     "Application Assignment Configuration",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceTrustedTokenIssuer,
 			TypeName: "aws_ssoadmin_trusted_token_issuer",
 			Name:     "Trusted Token Issuer",
+			Region:   types.ResourceRegionDefault(),
 			Tags:     &types.ServicePackageResourceTags{},
 		},
 	}
@@ -82,11 +92,13 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceInstances,
 			TypeName: "aws_ssoadmin_instances",
 			Name:     "Instances",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  DataSourcePermissionSet,
 			TypeName: "aws_ssoadmin_permission_set",
 			Name:     "Permission Set",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -97,37 +109,44 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceAccountAssignment,
 			TypeName: "aws_ssoadmin_account_assignment",
 			Name:     "Account Assignment",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceCustomerManagedPolicyAttachment,
 			TypeName: "aws_ssoadmin_customer_managed_policy_attachment",
 			Name:     "Customer Managed Policy Attachment",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceAccessControlAttributes,
 			TypeName: "aws_ssoadmin_instance_access_control_attributes",
 			Name:     "Instance Access Control Attributes",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourceManagedPolicyAttachment,
 			TypeName: "aws_ssoadmin_managed_policy_attachment",
 			Name:     "Managed Policy Attachment",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourcePermissionSet,
 			TypeName: "aws_ssoadmin_permission_set",
 			Name:     "Permission Set",
+			Region:   types.ResourceRegionDefault(),
 			Tags:     &types.ServicePackageResourceTags{},
 		},
 		{
 			Factory:  ResourcePermissionSetInlinePolicy,
 			TypeName: "aws_ssoadmin_permission_set_inline_policy",
 			Name:     "Permission Set Inline Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  ResourcePermissionsBoundaryAttachment,
 			TypeName: "aws_ssoadmin_permissions_boundary_attachment",
 			Name:     "Permissions Boundary Attachment",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
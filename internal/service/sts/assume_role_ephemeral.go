@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameAssumeRole = "Ephemeral Resource Assume Role"
+)
+
+// @EphemeralResource(aws_sts_assume_role, name="Assume Role")
+func newEphemeralAssumeRole(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAssumeRole{}, nil
+}
+
+type ephemeralAssumeRole struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralAssumeRole) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"assumed_role_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"assumed_role_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"duration_seconds": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(900, 43200),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrExternalID: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 1224),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=,.@:\/\-]*$`), ""),
+				},
+			},
+			"packed_policy_size": schema.Int32Attribute{
+				Computed: true,
+			},
+			names.AttrPolicy: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validators.JSON(),
+				},
+			},
+			"policy_arns": schema.SetAttribute{
+				CustomType: fwtypes.SetOfARNType,
+				Optional:   true,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"role_session_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=,.@\-]*$`), ""),
+				},
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"serial_number": schema.StringAttribute{
+				Optional: true,
+			},
+			"session_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"source_identity": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+				},
+			},
+			names.AttrTags: schema.MapAttribute{
+				CustomType: fwtypes.MapOfStringType,
+				Optional:   true,
+			},
+			"token_code": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"transitive_tag_keys": schema.SetAttribute{
+				CustomType: fwtypes.SetOfStringType,
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralAssumeRole) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().STSClient(ctx)
+	data := epAssumeRoleData{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RoleSessionName.IsNull() || data.RoleSessionName.IsUnknown() {
+		data.RoleSessionName = types.StringValue(sdkid.UniqueId())
+	}
+
+	input := sts.AssumeRoleInput{
+		DurationSeconds: fwflex.Int32FromFramework(ctx, data.DurationSeconds),
+		ExternalId:      fwflex.StringFromFramework(ctx, data.ExternalID),
+		Policy:          fwflex.StringFromFramework(ctx, data.Policy),
+		RoleArn:         fwflex.StringFromFramework(ctx, data.RoleARN),
+		RoleSessionName: fwflex.StringFromFramework(ctx, data.RoleSessionName),
+		SerialNumber:    fwflex.StringFromFramework(ctx, data.SerialNumber),
+		SourceIdentity:  fwflex.StringFromFramework(ctx, data.SourceIdentity),
+		TokenCode:       fwflex.StringFromFramework(ctx, data.TokenCode),
+	}
+
+	for _, v := range fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyARNs) {
+		input.PolicyArns = append(input.PolicyArns, awstypes.PolicyDescriptorType{
+			Arn: aws.String(v),
+		})
+	}
+	for k, v := range fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags) {
+		input.Tags = append(input.Tags, awstypes.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+	input.TransitiveTagKeys = fwflex.ExpandFrameworkStringValueSet(ctx, data.TransitiveTagKeys)
+
+	output, err := conn.AssumeRole(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.STS, create.ErrActionOpening, ERNameAssumeRole, data.RoleARN.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.AccessKeyID = fwflex.StringToFramework(ctx, output.Credentials.AccessKeyId)
+	data.AssumedRoleARN = fwflex.StringToFramework(ctx, output.AssumedRoleUser.Arn)
+	data.AssumedRoleID = fwflex.StringToFramework(ctx, output.AssumedRoleUser.AssumedRoleId)
+	data.Expiration = timetypes.NewRFC3339TimeValue(aws.ToTime(output.Credentials.Expiration).UTC().Truncate(time.Second))
+	data.PackedPolicySize = types.Int32PointerValue(output.PackedPolicySize)
+	data.SecretAccessKey = fwflex.StringToFramework(ctx, output.Credentials.SecretAccessKey)
+	data.SessionToken = fwflex.StringToFramework(ctx, output.Credentials.SessionToken)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epAssumeRoleData struct {
+	AccessKeyID       types.String        `tfsdk:"access_key_id"`
+	AssumedRoleARN    types.String        `tfsdk:"assumed_role_arn"`
+	AssumedRoleID     types.String        `tfsdk:"assumed_role_id"`
+	DurationSeconds   types.Int32         `tfsdk:"duration_seconds"`
+	Expiration        timetypes.RFC3339   `tfsdk:"expiration"`
+	ExternalID        types.String        `tfsdk:"external_id"`
+	PackedPolicySize  types.Int32         `tfsdk:"packed_policy_size"`
+	Policy            types.String        `tfsdk:"policy"`
+	PolicyARNs        fwtypes.SetOfARN    `tfsdk:"policy_arns"`
+	RoleARN           fwtypes.ARN         `tfsdk:"role_arn"`
+	RoleSessionName   types.String        `tfsdk:"role_session_name"`
+	SecretAccessKey   types.String        `tfsdk:"secret_access_key"`
+	SerialNumber      types.String        `tfsdk:"serial_number"`
+	SessionToken      types.String        `tfsdk:"session_token"`
+	SourceIdentity    types.String        `tfsdk:"source_identity"`
+	Tags              fwtypes.MapOfString `tfsdk:"tags"`
+	TokenCode         types.String        `tfsdk:"token_code"`
+	TransitiveTagKeys fwtypes.SetOfString `tfsdk:"transitive_tag_keys"`
+}
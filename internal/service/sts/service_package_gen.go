@@ -14,12 +14,30 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) EphemeralResources(ctx context.Context) []*types.ServicePackageEphemeralResource {
+	return []*types.ServicePackageEphemeralResource{
+		{
+			Factory:  newEphemeralAssumeRole,
+			TypeName: "aws_sts_assume_role",
+			Name:     "Assume Role",
+			Region:   types.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newEphemeralSessionToken,
+			TypeName: "aws_sts_session_token",
+			Name:     "Session Token",
+			Region:   types.ResourceRegionDefault(),
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
 		{
 			Factory:  newCallerIdentityDataSource,
 			TypeName: "aws_caller_identity",
 			Name:     "Caller Identity",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameSessionToken = "Ephemeral Resource Session Token"
+)
+
+// @EphemeralResource(aws_sts_session_token, name="Session Token")
+func newEphemeralSessionToken(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralSessionToken{}, nil
+}
+
+type ephemeralSessionToken struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralSessionToken) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"duration_seconds": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(900, 129600),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"serial_number": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("token_code")),
+				},
+			},
+			"session_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"token_code": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("serial_number")),
+				},
+			},
+		},
+	}
+}
+
+func (e *ephemeralSessionToken) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().STSClient(ctx)
+	data := epSessionTokenData{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := sts.GetSessionTokenInput{
+		DurationSeconds: fwflex.Int32FromFramework(ctx, data.DurationSeconds),
+		SerialNumber:    fwflex.StringFromFramework(ctx, data.SerialNumber),
+		TokenCode:       fwflex.StringFromFramework(ctx, data.TokenCode),
+	}
+
+	output, err := conn.GetSessionToken(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.STS, create.ErrActionOpening, ERNameSessionToken, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.AccessKeyID = fwflex.StringToFramework(ctx, output.Credentials.AccessKeyId)
+	data.Expiration = timetypes.NewRFC3339TimeValue(aws.ToTime(output.Credentials.Expiration).UTC().Truncate(time.Second))
+	data.SecretAccessKey = fwflex.StringToFramework(ctx, output.Credentials.SecretAccessKey)
+	data.SessionToken = fwflex.StringToFramework(ctx, output.Credentials.SessionToken)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epSessionTokenData struct {
+	AccessKeyID     types.String      `tfsdk:"access_key_id"`
+	DurationSeconds types.Int32       `tfsdk:"duration_seconds"`
+	Expiration      timetypes.RFC3339 `tfsdk:"expiration"`
+	SecretAccessKey types.String      `tfsdk:"secret_access_key"`
+	SerialNumber    types.String      `tfsdk:"serial_number"`
+	SessionToken    types.String      `tfsdk:"session_token"`
+	TokenCode       types.String      `tfsdk:"token_code"`
+}
@@ -20,11 +20,13 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourceRuntimeVersion,
 			TypeName: "aws_synthetics_runtime_version",
 			Name:     "Runtime Version",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newDataSourceRuntimeVersions,
 			TypeName: "aws_synthetics_runtime_versions",
 			Name:     "Runtime Versions",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -43,6 +45,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceCanary,
 			TypeName: "aws_synthetics_canary",
 			Name:     "Canary",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -51,6 +54,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceGroup,
 			TypeName: "aws_synthetics_group",
 			Name:     "Group",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -59,6 +63,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceGroupAssociation,
 			TypeName: "aws_synthetics_group_association",
 			Name:     "Group Association",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
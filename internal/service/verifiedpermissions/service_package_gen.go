@@ -20,6 +20,7 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory:  newDataSourcePolicyStore,
 			TypeName: "aws_verifiedpermissions_policy_store",
 			Name:     "Policy Store",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -30,26 +31,31 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory:  newResourceIdentitySource,
 			TypeName: "aws_verifiedpermissions_identity_source",
 			Name:     "Identity Source",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourcePolicy,
 			TypeName: "aws_verifiedpermissions_policy",
 			Name:     "Policy",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourcePolicyStore,
 			TypeName: "aws_verifiedpermissions_policy_store",
 			Name:     "Policy Store",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourcePolicyTemplate,
 			TypeName: "aws_verifiedpermissions_policy_template",
 			Name:     "Policy Template",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  newResourceSchema,
 			TypeName: "aws_verifiedpermissions_schema",
 			Name:     "Schema",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
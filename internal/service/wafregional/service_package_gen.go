@@ -28,26 +28,31 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceIPSet,
 			TypeName: "aws_wafregional_ipset",
 			Name:     "IPSet",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceRateBasedRule,
 			TypeName: "aws_wafregional_rate_based_rule",
 			Name:     "Rate Based Rule",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceRule,
 			TypeName: "aws_wafregional_rule",
 			Name:     "Rule",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceSubscribedRuleGroup,
 			TypeName: "aws_wafregional_subscribed_rule_group",
 			Name:     "Subscribed Rule Group",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  dataSourceWebACL,
 			TypeName: "aws_wafregional_web_acl",
 			Name:     "Web ACL",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
@@ -58,21 +63,25 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceByteMatchSet,
 			TypeName: "aws_wafregional_byte_match_set",
 			Name:     "Byte Match Set",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceGeoMatchSet,
 			TypeName: "aws_wafregional_geo_match_set",
 			Name:     "Geo Match Set",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceIPSet,
 			TypeName: "aws_wafregional_ipset",
 			Name:     "IPSet",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceRateBasedRule,
 			TypeName: "aws_wafregional_rate_based_rule",
 			Name:     "Rate Based Rule",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -81,16 +90,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceRegexMatchSet,
 			TypeName: "aws_wafregional_regex_match_set",
 			Name:     "Regex Match Set",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceRegexPatternSet,
 			TypeName: "aws_wafregional_regex_pattern_set",
 			Name:     "Regex Pattern Set",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceRule,
 			TypeName: "aws_wafregional_rule",
 			Name:     "Rule",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -99,6 +111,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceRuleGroup,
 			TypeName: "aws_wafregional_rule_group",
 			Name:     "Rule Group",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -107,16 +120,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceSizeConstraintSet,
 			TypeName: "aws_wafregional_size_constraint_set",
 			Name:     "Size Constraint Set",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceSQLInjectionMatchSet,
 			TypeName: "aws_wafregional_sql_injection_match_set",
 			Name:     "SQL Injection Match Set",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceWebACL,
 			TypeName: "aws_wafregional_web_acl",
 			Name:     "Web ACL",
+			Region:   types.ResourceRegionDefault(),
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
@@ -125,11 +141,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceWebACLAssociation,
 			TypeName: "aws_wafregional_web_acl_association",
 			Name:     "Web ACL Association",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceXSSMatchSet,
 			TypeName: "aws_wafregional_xss_match_set",
 			Name:     "XSS Match Set",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
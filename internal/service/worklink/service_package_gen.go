@@ -32,11 +32,13 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceFleet,
 			TypeName: "aws_worklink_fleet",
 			Name:     "Fleet",
+			Region:   types.ResourceRegionDefault(),
 		},
 		{
 			Factory:  resourceWebsiteCertificateAuthorityAssociation,
 			TypeName: "aws_worklink_website_certificate_authority_association",
 			Name:     "Website Certificate Authority Association",
+			Region:   types.ResourceRegionDefault(),
 		},
 	}
 }
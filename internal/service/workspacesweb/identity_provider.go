@@ -0,0 +1,300 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workspacesweb_identity_provider", name="Identity Provider")
+// @Tags(identifierAttribute="identity_provider_arn")
+func newIdentityProviderResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &identityProviderResource{}, nil
+}
+
+type identityProviderResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *identityProviderResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"identity_provider_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"identity_provider_details": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Sensitive:   true,
+			},
+			"identity_provider_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"identity_provider_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.IdentityProviderType](),
+				Required:   true,
+			},
+			"portal_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *identityProviderResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data identityProviderResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	var input workspacesweb.CreateIdentityProviderInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateIdentityProvider(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating WorkSpaces Web Identity Provider", err.Error())
+
+		return
+	}
+
+	identityProviderARN := aws.ToString(output.IdentityProviderArn)
+	identityProvider, err := findIdentityProviderByARN(ctx, conn, identityProviderARN)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Identity Provider (%s)", identityProviderARN), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, identityProvider, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *identityProviderResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data identityProviderResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	identityProviderARN := data.IdentityProviderARN.ValueString()
+	output, err := findIdentityProviderByARN(ctx, conn, identityProviderARN)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Identity Provider (%s)", identityProviderARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.PortalARN.IsNull() {
+		portalARN, err := portalARNFromIdentityProviderARN(identityProviderARN)
+
+		if err != nil {
+			response.Diagnostics.AddError("parsing Identity Provider ARN", err.Error())
+
+			return
+		}
+
+		data.PortalARN = fwtypes.ARNValue(portalARN)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *identityProviderResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old identityProviderResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input workspacesweb.UpdateIdentityProviderInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ClientToken = aws.String(sdkid.UniqueId())
+
+		output, err := conn.UpdateIdentityProvider(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkSpaces Web Identity Provider (%s)", new.IdentityProviderARN.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output.IdentityProvider, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *identityProviderResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data identityProviderResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	input := workspacesweb.DeleteIdentityProviderInput{
+		IdentityProviderArn: data.IdentityProviderARN.ValueStringPointer(),
+	}
+	_, err := conn.DeleteIdentityProvider(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Web Identity Provider (%s)", data.IdentityProviderARN.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *identityProviderResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("identity_provider_arn"), request, response)
+}
+
+func findIdentityProviderByARN(ctx context.Context, conn *workspacesweb.Client, identityProviderARN string) (*awstypes.IdentityProvider, error) {
+	input := workspacesweb.GetIdentityProviderInput{
+		IdentityProviderArn: aws.String(identityProviderARN),
+	}
+	output, err := conn.GetIdentityProvider(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.IdentityProvider == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.IdentityProvider, nil
+}
+
+// portalARNFromIdentityProviderARN returns the ARN of the portal that owns the specified identity provider.
+// Identity provider ARNs have the form arn:${Partition}:workspaces-web:${Region}:${Account}:identityProvider/${PortalID}/${IdentityProviderID}.
+func portalARNFromIdentityProviderARN(identityProviderARN string) (string, error) {
+	v, err := arn.Parse(identityProviderARN)
+
+	if err != nil {
+		return "", err
+	}
+
+	parts := strings.Split(v.Resource, "/")
+
+	if len(parts) != 3 || parts[0] != "identityProvider" {
+		return "", fmt.Errorf("unexpected format for Identity Provider ARN (%s)", identityProviderARN)
+	}
+
+	v.Resource = "portal/" + parts[1]
+
+	return v.String(), nil
+}
+
+type identityProviderResourceModel struct {
+	IdentityProviderARN     types.String                                      `tfsdk:"identity_provider_arn"`
+	IdentityProviderDetails fwtypes.MapOfString                               `tfsdk:"identity_provider_details"`
+	IdentityProviderName    types.String                                      `tfsdk:"identity_provider_name"`
+	IdentityProviderType    fwtypes.StringEnum[awstypes.IdentityProviderType] `tfsdk:"identity_provider_type"`
+	PortalARN               fwtypes.ARN                                       `tfsdk:"portal_arn"`
+	Tags                    tftags.Map                                        `tfsdk:"tags"`
+	TagsAll                 tftags.Map                                        `tfsdk:"tags_all"`
+}
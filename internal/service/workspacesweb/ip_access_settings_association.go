@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkResource("aws_workspacesweb_ip_access_settings_association", name="IP Access Settings Association")
+func newIPAccessSettingsAssociationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &ipAccessSettingsAssociationResource{}, nil
+}
+
+type ipAccessSettingsAssociationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+}
+
+func (r *ipAccessSettingsAssociationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ip_access_settings_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"portal_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ipAccessSettingsAssociationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data ipAccessSettingsAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	input := workspacesweb.AssociateIpAccessSettingsInput{
+		IpAccessSettingsArn: data.IPAccessSettingsARN.ValueStringPointer(),
+		PortalArn:           data.PortalARN.ValueStringPointer(),
+	}
+	_, err := conn.AssociateIpAccessSettings(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating WorkSpaces Web IP Access Settings Association (%s)", data.id()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *ipAccessSettingsAssociationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data ipAccessSettingsAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	err := findIPAccessSettingsAssociationByTwoPartKey(ctx, conn, data.IPAccessSettingsARN.ValueString(), data.PortalARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web IP Access Settings Association (%s)", data.id()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *ipAccessSettingsAssociationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data ipAccessSettingsAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	input := workspacesweb.DisassociateIpAccessSettingsInput{
+		PortalArn: data.PortalARN.ValueStringPointer(),
+	}
+	_, err := conn.DisassociateIpAccessSettings(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Web IP Access Settings Association (%s)", data.id()), err.Error())
+
+		return
+	}
+}
+
+func (r *ipAccessSettingsAssociationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts, err := flex.ExpandResourceId(request.ID, ipAccessSettingsAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("ip_access_settings_arn"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("portal_arn"), parts[1])...)
+}
+
+func findIPAccessSettingsAssociationByTwoPartKey(ctx context.Context, conn *workspacesweb.Client, ipAccessSettingsARN, portalARN string) error {
+	portal, err := findPortalByARN(ctx, conn, portalARN)
+
+	if err != nil {
+		return err
+	}
+
+	if aws.ToString(portal.IpAccessSettingsArn) != ipAccessSettingsARN {
+		return &retry.NotFoundError{}
+	}
+
+	return nil
+}
+
+const (
+	ipAccessSettingsAssociationResourceIDPartCount = 2
+)
+
+type ipAccessSettingsAssociationResourceModel struct {
+	IPAccessSettingsARN fwtypes.ARN `tfsdk:"ip_access_settings_arn"`
+	PortalARN           fwtypes.ARN `tfsdk:"portal_arn"`
+}
+
+func (m *ipAccessSettingsAssociationResourceModel) id() string {
+	return m.IPAccessSettingsARN.ValueString() + "," + m.PortalARN.ValueString()
+}
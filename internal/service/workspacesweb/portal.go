@@ -0,0 +1,469 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workspacesweb_portal", name="Portal")
+// @Tags(identifierAttribute="portal_arn")
+func newPortalResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &portalResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type portalResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *portalResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"additional_encryption_context": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"authentication_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AuthenticationType](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"browser_settings_arn": portalAssociatedARNAttribute(),
+			"browser_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BrowserType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrCreationDate: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_managed_key": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"data_protection_settings_arn": portalAssociatedARNAttribute(),
+			names.AttrDisplayName: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			names.AttrInstanceType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.InstanceType](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip_access_settings_arn": portalAssociatedARNAttribute(),
+			"max_concurrent_sessions": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_settings_arn": portalAssociatedARNAttribute(),
+			"portal_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"portal_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"portal_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PortalStatus](),
+				Computed:   true,
+			},
+			"renderer_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RendererType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatusReason: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:                     tftags.TagsAttribute(),
+			names.AttrTagsAll:                  tftags.TagsAttributeComputedOnly(),
+			"trust_store_arn":                  portalAssociatedARNAttribute(),
+			"user_access_logging_settings_arn": portalAssociatedARNAttribute(),
+			"user_settings_arn":                portalAssociatedARNAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// portalAssociatedARNAttribute returns the schema for the ARN of a settings resource associated with a portal.
+// Associations are managed by the separate association resources.
+func portalAssociatedARNAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (r *portalResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data portalResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	var input workspacesweb.CreatePortalInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreatePortal(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating WorkSpaces Web Portal", err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.PortalArn)
+	portal, err := waitPortalCreated(ctx, conn, arn, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("portal_arn"), arn) // Set 'portal_arn' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for WorkSpaces Web Portal (%s) create", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, portal, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *portalResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data portalResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	output, err := findPortalByARN(ctx, conn, data.PortalARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Portal (%s)", data.PortalARN.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *portalResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old portalResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input workspacesweb.UpdatePortalInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		arn := new.PortalARN.ValueString()
+		_, err := conn.UpdatePortal(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkSpaces Web Portal (%s)", arn), err.Error())
+
+			return
+		}
+
+		portal, err := waitPortalUpdated(ctx, conn, arn, r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for WorkSpaces Web Portal (%s) update", arn), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, portal, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.PortalStatus = old.PortalStatus
+		new.StatusReason = old.StatusReason
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *portalResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data portalResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	arn := data.PortalARN.ValueString()
+	input := workspacesweb.DeletePortalInput{
+		PortalArn: aws.String(arn),
+	}
+	_, err := conn.DeletePortal(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Web Portal (%s)", arn), err.Error())
+
+		return
+	}
+
+	if _, err := waitPortalDeleted(ctx, conn, arn, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for WorkSpaces Web Portal (%s) delete", arn), err.Error())
+
+		return
+	}
+}
+
+func (r *portalResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("portal_arn"), request, response)
+}
+
+func findPortalByARN(ctx context.Context, conn *workspacesweb.Client, arn string) (*awstypes.Portal, error) {
+	input := workspacesweb.GetPortalInput{
+		PortalArn: aws.String(arn),
+	}
+	output, err := conn.GetPortal(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Portal == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Portal, nil
+}
+
+func statusPortal(ctx context.Context, conn *workspacesweb.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findPortalByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.PortalStatus), nil
+	}
+}
+
+func waitPortalCreated(ctx context.Context, conn *workspacesweb.Client, arn string, timeout time.Duration) (*awstypes.Portal, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PortalStatusPending),
+		Target:  enum.Slice(awstypes.PortalStatusIncomplete, awstypes.PortalStatusActive),
+		Refresh: statusPortal(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Portal); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPortalUpdated(ctx context.Context, conn *workspacesweb.Client, arn string, timeout time.Duration) (*awstypes.Portal, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PortalStatusPending),
+		Target:  enum.Slice(awstypes.PortalStatusIncomplete, awstypes.PortalStatusActive),
+		Refresh: statusPortal(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Portal); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPortalDeleted(ctx context.Context, conn *workspacesweb.Client, arn string, timeout time.Duration) (*awstypes.Portal, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PortalStatusIncomplete, awstypes.PortalStatusPending, awstypes.PortalStatusActive),
+		Target:  []string{},
+		Refresh: statusPortal(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Portal); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type portalResourceModel struct {
+	AdditionalEncryptionContext  fwtypes.MapOfString                             `tfsdk:"additional_encryption_context"`
+	AuthenticationType           fwtypes.StringEnum[awstypes.AuthenticationType] `tfsdk:"authentication_type"`
+	BrowserSettingsARN           types.String                                    `tfsdk:"browser_settings_arn"`
+	BrowserType                  fwtypes.StringEnum[awstypes.BrowserType]        `tfsdk:"browser_type"`
+	CreationDate                 timetypes.RFC3339                               `tfsdk:"creation_date"`
+	CustomerManagedKey           fwtypes.ARN                                     `tfsdk:"customer_managed_key"`
+	DataProtectionSettingsARN    types.String                                    `tfsdk:"data_protection_settings_arn"`
+	DisplayName                  types.String                                    `tfsdk:"display_name"`
+	InstanceType                 fwtypes.StringEnum[awstypes.InstanceType]       `tfsdk:"instance_type"`
+	IPAccessSettingsARN          types.String                                    `tfsdk:"ip_access_settings_arn"`
+	MaxConcurrentSessions        types.Int32                                     `tfsdk:"max_concurrent_sessions"`
+	NetworkSettingsARN           types.String                                    `tfsdk:"network_settings_arn"`
+	PortalARN                    types.String                                    `tfsdk:"portal_arn"`
+	PortalEndpoint               types.String                                    `tfsdk:"portal_endpoint"`
+	PortalStatus                 fwtypes.StringEnum[awstypes.PortalStatus]       `tfsdk:"portal_status"`
+	RendererType                 fwtypes.StringEnum[awstypes.RendererType]       `tfsdk:"renderer_type"`
+	StatusReason                 types.String                                    `tfsdk:"status_reason"`
+	Tags                         tftags.Map                                      `tfsdk:"tags"`
+	TagsAll                      tftags.Map                                      `tfsdk:"tags_all"`
+	Timeouts                     timeouts.Value                                  `tfsdk:"timeouts"`
+	TrustStoreARN                types.String                                    `tfsdk:"trust_store_arn"`
+	UserAccessLoggingSettingsARN types.String                                    `tfsdk:"user_access_logging_settings_arn"`
+	UserSettingsARN              types.String                                    `tfsdk:"user_settings_arn"`
+}
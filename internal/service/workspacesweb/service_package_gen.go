@@ -19,7 +19,116 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory:  newBrowserSettingsResource,
+			TypeName: "aws_workspacesweb_browser_settings",
+			Name:     "Browser Settings",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "browser_settings_arn",
+			},
+		},
+		{
+			Factory:  newBrowserSettingsAssociationResource,
+			TypeName: "aws_workspacesweb_browser_settings_association",
+			Name:     "Browser Settings Association",
+			Region:   types.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newIdentityProviderResource,
+			TypeName: "aws_workspacesweb_identity_provider",
+			Name:     "Identity Provider",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "identity_provider_arn",
+			},
+		},
+		{
+			Factory:  newIPAccessSettingsResource,
+			TypeName: "aws_workspacesweb_ip_access_settings",
+			Name:     "IP Access Settings",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "ip_access_settings_arn",
+			},
+		},
+		{
+			Factory:  newIPAccessSettingsAssociationResource,
+			TypeName: "aws_workspacesweb_ip_access_settings_association",
+			Name:     "IP Access Settings Association",
+			Region:   types.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newNetworkSettingsResource,
+			TypeName: "aws_workspacesweb_network_settings",
+			Name:     "Network Settings",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "network_settings_arn",
+			},
+		},
+		{
+			Factory:  newNetworkSettingsAssociationResource,
+			TypeName: "aws_workspacesweb_network_settings_association",
+			Name:     "Network Settings Association",
+			Region:   types.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newPortalResource,
+			TypeName: "aws_workspacesweb_portal",
+			Name:     "Portal",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "portal_arn",
+			},
+		},
+		{
+			Factory:  newTrustStoreResource,
+			TypeName: "aws_workspacesweb_trust_store",
+			Name:     "Trust Store",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "trust_store_arn",
+			},
+		},
+		{
+			Factory:  newTrustStoreAssociationResource,
+			TypeName: "aws_workspacesweb_trust_store_association",
+			Name:     "Trust Store Association",
+			Region:   types.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newUserAccessLoggingSettingsResource,
+			TypeName: "aws_workspacesweb_user_access_logging_settings",
+			Name:     "User Access Logging Settings",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "user_access_logging_settings_arn",
+			},
+		},
+		{
+			Factory:  newUserAccessLoggingSettingsAssociationResource,
+			TypeName: "aws_workspacesweb_user_access_logging_settings_association",
+			Name:     "User Access Logging Settings Association",
+			Region:   types.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newUserSettingsResource,
+			TypeName: "aws_workspacesweb_user_settings",
+			Name:     "User Settings",
+			Region:   types.ResourceRegionDefault(),
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "user_settings_arn",
+			},
+		},
+		{
+			Factory:  newUserSettingsAssociationResource,
+			TypeName: "aws_workspacesweb_user_settings_association",
+			Name:     "User Settings Association",
+			Region:   types.ResourceRegionDefault(),
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
@@ -0,0 +1,411 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workspacesweb_trust_store", name="Trust Store")
+// @Tags(identifierAttribute="trust_store_arn")
+func newTrustStoreResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &trustStoreResource{}, nil
+}
+
+type trustStoreResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *trustStoreResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"associated_portal_arns": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"trust_store_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrCertificate: schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[certificateModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"body": schema.StringAttribute{
+							Required: true,
+						},
+						"issuer": schema.StringAttribute{
+							Computed: true,
+						},
+						"not_valid_after": schema.StringAttribute{
+							CustomType: timetypes.RFC3339Type{},
+							Computed:   true,
+						},
+						"not_valid_before": schema.StringAttribute{
+							CustomType: timetypes.RFC3339Type{},
+							Computed:   true,
+						},
+						"subject": schema.StringAttribute{
+							Computed: true,
+						},
+						"thumbprint": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *trustStoreResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data trustStoreResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	certificates, diags := data.Certificates.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := workspacesweb.CreateTrustStoreInput{
+		CertificateList: certificateBodies(certificates),
+		ClientToken:     aws.String(sdkid.UniqueId()),
+		Tags:            getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateTrustStore(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating WorkSpaces Web Trust Store", err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.TrustStoreArn)
+	data.TrustStoreARN = fwflex.StringValueToFramework(ctx, arn)
+
+	response.Diagnostics.Append(r.refresh(ctx, conn, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *trustStoreResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data trustStoreResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	_, err := findTrustStoreByARN(ctx, conn, data.TrustStoreARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s)", data.TrustStoreARN.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(r.refresh(ctx, conn, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *trustStoreResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old trustStoreResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	if !new.Certificates.Equal(old.Certificates) {
+		newCertificates, diags := new.Certificates.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		oldCertificates, diags := old.Certificates.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		newBodies := make(map[string]struct{})
+		for _, v := range newCertificates {
+			newBodies[v.Body.ValueString()] = struct{}{}
+		}
+		oldBodies := make(map[string]struct{})
+		var thumbprintsToDelete []string
+		for _, v := range oldCertificates {
+			oldBodies[v.Body.ValueString()] = struct{}{}
+			if _, ok := newBodies[v.Body.ValueString()]; !ok {
+				thumbprintsToDelete = append(thumbprintsToDelete, v.Thumbprint.ValueString())
+			}
+		}
+		var certificatesToAdd [][]byte
+		for _, v := range newCertificates {
+			if _, ok := oldBodies[v.Body.ValueString()]; !ok {
+				certificatesToAdd = append(certificatesToAdd, []byte(v.Body.ValueString()))
+			}
+		}
+
+		input := workspacesweb.UpdateTrustStoreInput{
+			CertificatesToAdd:    certificatesToAdd,
+			CertificatesToDelete: thumbprintsToDelete,
+			ClientToken:          aws.String(sdkid.UniqueId()),
+			TrustStoreArn:        new.TrustStoreARN.ValueStringPointer(),
+		}
+
+		_, err := conn.UpdateTrustStore(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkSpaces Web Trust Store (%s)", new.TrustStoreARN.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(r.refresh(ctx, conn, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *trustStoreResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data trustStoreResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	input := workspacesweb.DeleteTrustStoreInput{
+		TrustStoreArn: data.TrustStoreARN.ValueStringPointer(),
+	}
+	_, err := conn.DeleteTrustStore(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Web Trust Store (%s)", data.TrustStoreARN.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *trustStoreResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("trust_store_arn"), request, response)
+}
+
+// refresh sets the trust store's computed attributes and certificates from the API.
+func (r *trustStoreResource) refresh(ctx context.Context, conn *workspacesweb.Client, data *trustStoreResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	arn := data.TrustStoreARN.ValueString()
+
+	trustStore, err := findTrustStoreByARN(ctx, conn, arn)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s)", arn), err.Error())
+
+		return diags
+	}
+
+	diags.Append(fwflex.Flatten(ctx, trustStore, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	certificates, err := findTrustStoreCertificatesByARN(ctx, conn, arn)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s) certificates", arn), err.Error())
+
+		return diags
+	}
+
+	data.Certificates = fwtypes.NewSetNestedObjectValueOfValueSliceMust(ctx, tfslices.ApplyToAll(certificates, func(v awstypes.Certificate) certificateModel {
+		return certificateModel{
+			Body:           fwflex.StringValueToFramework(ctx, string(v.Body)),
+			Issuer:         fwflex.StringToFramework(ctx, v.Issuer),
+			NotValidAfter:  fwflex.TimeToFramework(ctx, v.NotValidAfter),
+			NotValidBefore: fwflex.TimeToFramework(ctx, v.NotValidBefore),
+			Subject:        fwflex.StringToFramework(ctx, v.Subject),
+			Thumbprint:     fwflex.StringToFramework(ctx, v.Thumbprint),
+		}
+	}))
+
+	return diags
+}
+
+func certificateBodies(certificates []*certificateModel) [][]byte {
+	return tfslices.ApplyToAll(certificates, func(v *certificateModel) []byte {
+		return []byte(v.Body.ValueString())
+	})
+}
+
+func findTrustStoreByARN(ctx context.Context, conn *workspacesweb.Client, arn string) (*awstypes.TrustStore, error) {
+	input := workspacesweb.GetTrustStoreInput{
+		TrustStoreArn: aws.String(arn),
+	}
+	output, err := conn.GetTrustStore(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TrustStore == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TrustStore, nil
+}
+
+func findTrustStoreCertificatesByARN(ctx context.Context, conn *workspacesweb.Client, arn string) ([]awstypes.Certificate, error) {
+	input := workspacesweb.ListTrustStoreCertificatesInput{
+		TrustStoreArn: aws.String(arn),
+	}
+	var output []awstypes.Certificate
+
+	pages := workspacesweb.NewListTrustStoreCertificatesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.CertificateList {
+			certificate, err := findTrustStoreCertificateByTwoPartKey(ctx, conn, arn, aws.ToString(v.Thumbprint))
+
+			if err != nil {
+				return nil, err
+			}
+
+			output = append(output, *certificate)
+		}
+	}
+
+	return output, nil
+}
+
+func findTrustStoreCertificateByTwoPartKey(ctx context.Context, conn *workspacesweb.Client, arn, thumbprint string) (*awstypes.Certificate, error) {
+	input := workspacesweb.GetTrustStoreCertificateInput{
+		Thumbprint:    aws.String(thumbprint),
+		TrustStoreArn: aws.String(arn),
+	}
+	output, err := conn.GetTrustStoreCertificate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Certificate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Certificate, nil
+}
+
+type trustStoreResourceModel struct {
+	AssociatedPortalARNs fwtypes.ListOfString                             `tfsdk:"associated_portal_arns"`
+	Certificates         fwtypes.SetNestedObjectValueOf[certificateModel] `tfsdk:"certificate" autoflex:"-"`
+	Tags                 tftags.Map                                       `tfsdk:"tags"`
+	TagsAll              tftags.Map                                       `tfsdk:"tags_all"`
+	TrustStoreARN        types.String                                     `tfsdk:"trust_store_arn"`
+}
+
+type certificateModel struct {
+	Body           types.String      `tfsdk:"body"`
+	Issuer         types.String      `tfsdk:"issuer"`
+	NotValidAfter  timetypes.RFC3339 `tfsdk:"not_valid_after"`
+	NotValidBefore timetypes.RFC3339 `tfsdk:"not_valid_before"`
+	Subject        types.String      `tfsdk:"subject"`
+	Thumbprint     types.String      `tfsdk:"thumbprint"`
+}
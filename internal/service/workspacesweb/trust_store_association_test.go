@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkSpacesWebTrustStoreAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workspacesweb_trust_store_association.test"
+	caKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificatePEM(t, caKey)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustStoreAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustStoreAssociationConfig_basic(rName, caCertificate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrustStoreAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "trust_store_arn", "aws_workspacesweb_trust_store.test", "trust_store_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "portal_arn", "aws_workspacesweb_portal.test", "portal_arn"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccImportStateIDFunc(resourceName, "trust_store_arn", "portal_arn"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "trust_store_arn",
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebTrustStoreAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workspacesweb_trust_store_association.test"
+	caKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificatePEM(t, caKey)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustStoreAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustStoreAssociationConfig_basic(rName, caCertificate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrustStoreAssociationExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappearsWithStateFunc(ctx, acctest.Provider, tfworkspacesweb.ResourceTrustStoreAssociation, resourceName, testAccDisappearsStateFunc("trust_store_arn", "portal_arn")),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTrustStoreAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspacesweb_trust_store_association" {
+				continue
+			}
+
+			err := tfworkspacesweb.FindTrustStoreAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["trust_store_arn"], rs.Primary.Attributes["portal_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkSpaces Web Trust Store Association %s,%s still exists", rs.Primary.Attributes["trust_store_arn"], rs.Primary.Attributes["portal_arn"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTrustStoreAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		return tfworkspacesweb.FindTrustStoreAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["trust_store_arn"], rs.Primary.Attributes["portal_arn"])
+	}
+}
+
+func testAccTrustStoreAssociationConfig_basic(rName, certificate string) string {
+	return acctest.ConfigCompose(testAccTrustStoreConfig_basic(rName, certificate), fmt.Sprintf(`
+resource "aws_workspacesweb_portal" "test" {
+  display_name = %[1]q
+}
+
+resource "aws_workspacesweb_trust_store_association" "test" {
+  trust_store_arn = aws_workspacesweb_trust_store.test.trust_store_arn
+  portal_arn      = aws_workspacesweb_portal.test.portal_arn
+}
+`, rName))
+}
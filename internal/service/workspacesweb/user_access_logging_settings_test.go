@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkSpacesWebUserAccessLoggingSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.UserAccessLoggingSettings
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workspacesweb_user_access_logging_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserAccessLoggingSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserAccessLoggingSettingsConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserAccessLoggingSettingsExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, "user_access_logging_settings_arn", "workspaces-web", regexache.MustCompile(`userAccessLoggingSettings/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "kinesis_stream_arn", "aws_kinesis_stream.test.0", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "user_access_logging_settings_arn"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "user_access_logging_settings_arn",
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebUserAccessLoggingSettings_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.UserAccessLoggingSettings
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workspacesweb_user_access_logging_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserAccessLoggingSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserAccessLoggingSettingsConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserAccessLoggingSettingsExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappearsWithStateFunc(ctx, acctest.Provider, tfworkspacesweb.ResourceUserAccessLoggingSettings, resourceName, testAccDisappearsStateFunc("user_access_logging_settings_arn")),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebUserAccessLoggingSettings_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.UserAccessLoggingSettings
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workspacesweb_user_access_logging_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserAccessLoggingSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserAccessLoggingSettingsConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserAccessLoggingSettingsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "kinesis_stream_arn", "aws_kinesis_stream.test.0", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccUserAccessLoggingSettingsConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserAccessLoggingSettingsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "kinesis_stream_arn", "aws_kinesis_stream.test.1", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUserAccessLoggingSettingsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspacesweb_user_access_logging_settings" {
+				continue
+			}
+
+			_, err := tfworkspacesweb.FindUserAccessLoggingSettingsByARN(ctx, conn, rs.Primary.Attributes["user_access_logging_settings_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkSpaces Web User Access Logging Settings %s still exists", rs.Primary.Attributes["user_access_logging_settings_arn"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckUserAccessLoggingSettingsExists(ctx context.Context, n string, v *awstypes.UserAccessLoggingSettings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		output, err := tfworkspacesweb.FindUserAccessLoggingSettingsByARN(ctx, conn, rs.Primary.Attributes["user_access_logging_settings_arn"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccUserAccessLoggingSettingsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+# The stream name must begin with "amazon-workspaces-web-".
+resource "aws_kinesis_stream" "test" {
+  count = 2
+
+  name        = "amazon-workspaces-web-%[1]s-${count.index}"
+  shard_count = 1
+}
+`, rName)
+}
+
+func testAccUserAccessLoggingSettingsConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccUserAccessLoggingSettingsConfig_base(rName), `
+resource "aws_workspacesweb_user_access_logging_settings" "test" {
+  kinesis_stream_arn = aws_kinesis_stream.test[0].arn
+}
+`)
+}
+
+func testAccUserAccessLoggingSettingsConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccUserAccessLoggingSettingsConfig_base(rName), `
+resource "aws_workspacesweb_user_access_logging_settings" "test" {
+  kinesis_stream_arn = aws_kinesis_stream.test[1].arn
+}
+`)
+}
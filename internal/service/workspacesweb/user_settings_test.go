@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkSpacesWebUserSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.UserSettings
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workspacesweb_user_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserSettingsConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserSettingsExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, "user_settings_arn", "workspaces-web", regexache.MustCompile(`userSettings/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "copy_allowed", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "download_allowed", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "paste_allowed", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "print_allowed", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "upload_allowed", "Enabled"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "user_settings_arn"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "user_settings_arn",
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebUserSettings_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.UserSettings
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workspacesweb_user_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserSettingsConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserSettingsExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappearsWithStateFunc(ctx, acctest.Provider, tfworkspacesweb.ResourceUserSettings, resourceName, testAccDisappearsStateFunc("user_settings_arn")),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebUserSettings_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.UserSettings
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_workspacesweb_user_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserSettingsConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserSettingsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "copy_allowed", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "download_allowed", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "paste_allowed", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "print_allowed", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "upload_allowed", "Enabled"),
+				),
+			},
+			{
+				Config: testAccUserSettingsConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserSettingsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "copy_allowed", "Disabled"),
+					resource.TestCheckResourceAttr(resourceName, "disconnect_timeout_in_minutes", "120"),
+					resource.TestCheckResourceAttr(resourceName, "idle_disconnect_timeout_in_minutes", "30"),
+					resource.TestCheckResourceAttr(resourceName, "cookie_synchronization_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cookie_synchronization_configuration.0.allowlist.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cookie_synchronization_configuration.0.allowlist.0.domain", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "toolbar_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "toolbar_configuration.0.toolbar_type", "Docked"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUserSettingsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspacesweb_user_settings" {
+				continue
+			}
+
+			_, err := tfworkspacesweb.FindUserSettingsByARN(ctx, conn, rs.Primary.Attributes["user_settings_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkSpaces Web User Settings %s still exists", rs.Primary.Attributes["user_settings_arn"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckUserSettingsExists(ctx context.Context, n string, v *awstypes.UserSettings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		output, err := tfworkspacesweb.FindUserSettingsByARN(ctx, conn, rs.Primary.Attributes["user_settings_arn"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccUserSettingsConfig_basic(string) string {
+	return `
+resource "aws_workspacesweb_user_settings" "test" {
+  copy_allowed     = "Enabled"
+  download_allowed = "Enabled"
+  paste_allowed    = "Enabled"
+  print_allowed    = "Enabled"
+  upload_allowed   = "Enabled"
+}
+`
+}
+
+func testAccUserSettingsConfig_updated(string) string {
+	return `
+resource "aws_workspacesweb_user_settings" "test" {
+  copy_allowed                       = "Disabled"
+  download_allowed                   = "Enabled"
+  paste_allowed                      = "Enabled"
+  print_allowed                      = "Enabled"
+  upload_allowed                     = "Enabled"
+  disconnect_timeout_in_minutes      = 120
+  idle_disconnect_timeout_in_minutes = 30
+
+  cookie_synchronization_configuration {
+    allowlist {
+      domain = "example.com"
+    }
+  }
+
+  toolbar_configuration {
+    toolbar_type = "Docked"
+    visual_mode  = "Dark"
+  }
+}
+`
+}
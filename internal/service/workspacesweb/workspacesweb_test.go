@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+)
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+	var input workspacesweb.ListPortalsInput
+
+	_, err := conn.ListPortals(ctx, &input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+// testAccDisappearsStateFunc returns a state function that copies the named identifying attributes
+// from Terraform state into the framework state used by acctest.CheckFrameworkResourceDisappearsWithStateFunc.
+func testAccDisappearsStateFunc(attrNames ...string) func(context.Context, *tfsdk.State, *terraform.InstanceState) error {
+	return func(ctx context.Context, state *tfsdk.State, is *terraform.InstanceState) error {
+		for _, attrName := range attrNames {
+			v, ok := is.Attributes[attrName]
+			if !ok {
+				return fmt.Errorf("Identifying attribute %q not defined", attrName)
+			}
+
+			if err := fwdiag.DiagnosticsError(state.SetAttribute(ctx, path.Root(attrName), v)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccImportStateIDFunc returns an import ID made of the named attributes separated by commas.
+func testAccImportStateIDFunc(n string, attrNames ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		parts := make([]string, 0, len(attrNames))
+		for _, attrName := range attrNames {
+			parts = append(parts, rs.Primary.Attributes[attrName])
+		}
+
+		return strings.Join(parts, ","), nil
+	}
+}
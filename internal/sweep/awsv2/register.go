@@ -13,6 +13,8 @@ import (
 )
 
 func Register(name string, f sweep.SweeperFn, dependencies ...string) {
+	sweep.AddSweeper(name, f, dependencies...)
+
 	resource.AddTestSweepers(name, &resource.Sweeper{
 		Name: name,
 		F: func(region string) error {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -77,6 +78,28 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return deleteResource(ctx, state, resource)
 }
 
+// ID returns the ID of the resource to be swept, if it has been set.
+func (sr *sweepResource) ID() string {
+	if v, ok := sr.Attribute(names.AttrID); ok {
+		if v, ok := v.(string); ok {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// Attribute returns the value of the specified top-level attribute of the resource to be swept, if it has been set.
+func (sr *sweepResource) Attribute(name string) (any, bool) {
+	for _, attr := range sr.attributes {
+		if attr.path == name {
+			return attr.value, true
+		}
+	}
+
+	return nil, false
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
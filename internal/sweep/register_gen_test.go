@@ -59,6 +59,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/docdb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/docdbelastic"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ds"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/dsql"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ecr"
@@ -87,6 +88,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/glue"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/healthlake"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/internetmonitor"
@@ -121,6 +123,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/osis"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/pcs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpointsmsvoicev2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pipes"
@@ -175,6 +178,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/xray"
 )
 
@@ -235,6 +239,7 @@ func registerSweepers() {
 	docdb.RegisterSweepers()
 	docdbelastic.RegisterSweepers()
 	ds.RegisterSweepers()
+	dsql.RegisterSweepers()
 	dynamodb.RegisterSweepers()
 	ec2.RegisterSweepers()
 	ecr.RegisterSweepers()
@@ -263,6 +268,7 @@ func registerSweepers() {
 	glue.RegisterSweepers()
 	grafana.RegisterSweepers()
 	guardduty.RegisterSweepers()
+	healthlake.RegisterSweepers()
 	iam.RegisterSweepers()
 	imagebuilder.RegisterSweepers()
 	internetmonitor.RegisterSweepers()
@@ -297,6 +303,7 @@ func registerSweepers() {
 	opensearchserverless.RegisterSweepers()
 	organizations.RegisterSweepers()
 	osis.RegisterSweepers()
+	pcs.RegisterSweepers()
 	pinpoint.RegisterSweepers()
 	pinpointsmsvoicev2.RegisterSweepers()
 	pipes.RegisterSweepers()
@@ -351,5 +358,6 @@ func registerSweepers() {
 	wafregional.RegisterSweepers()
 	wafv2.RegisterSweepers()
 	workspaces.RegisterSweepers()
+	workspacesweb.RegisterSweepers()
 	xray.RegisterSweepers()
 }
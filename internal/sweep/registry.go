@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"log"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Sweeper is a resource sweeper.
+// Sweepers registered with AddSweeper list the resources to be swept without deleting them.
+// Legacy sweepers registered with AddTestSweepers delete resources without reporting them.
+type Sweeper struct {
+	Name string
+	F    SweeperFn
+	// LegacyF is set instead of F for sweepers registered with AddTestSweepers.
+	LegacyF func(region string) error
+	// Dependencies are the names of the sweepers that must run before this one.
+	Dependencies []string
+}
+
+// sweepers holds all sweepers registered with AddSweeper or AddTestSweepers.
+var sweepers = make(map[string]*Sweeper)
+
+// AddSweeper registers a sweeper for use by the sweeper runner.
+func AddSweeper(name string, f SweeperFn, dependencies ...string) {
+	addSweeper(&Sweeper{
+		Name:         name,
+		F:            f,
+		Dependencies: dependencies,
+	})
+}
+
+// AddTestSweepers registers a legacy sweeper with both the acceptance test framework and the sweeper runner.
+// It should be used instead of calling `resource.AddTestSweepers` directly.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	addSweeper(&Sweeper{
+		Name:         name,
+		LegacyF:      s.F,
+		Dependencies: s.Dependencies,
+	})
+
+	resource.AddTestSweepers(name, s)
+}
+
+func addSweeper(s *Sweeper) {
+	if _, ok := sweepers[s.Name]; ok {
+		log.Fatalf("[ERR] Error adding (%s) to sweepers: sweeper already exists", s.Name)
+	}
+
+	sweepers[s.Name] = s
+}
+
+// Sweepers returns all registered sweepers, indexed by name.
+func Sweepers() map[string]*Sweeper {
+	return maps.Clone(sweepers)
+}
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package runner
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// TagFilter selects resources with the specified tag key and, if any values are specified, one of the values.
+type TagFilter struct {
+	Key    string
+	Values []string
+}
+
+// identifier is implemented by Sweepables that know the ID of the resource to be swept.
+type identifier interface {
+	ID() string
+}
+
+// attributer is implemented by Sweepables that know some of the attribute values of the resource to be swept.
+type attributer interface {
+	Attribute(name string) (any, bool)
+}
+
+// describe returns a report describing the resource to be swept.
+// No AWS APIs are called.
+func describe(v sweep.Sweepable) *ResourceReport {
+	var rr ResourceReport
+
+	if v, ok := v.(identifier); ok {
+		rr.ID = v.ID()
+	}
+
+	if v, ok := v.(attributer); ok {
+		if v, ok := v.Attribute(names.AttrARN); ok {
+			rr.ARN, _ = v.(string)
+		}
+		if v, ok := v.Attribute(names.AttrName); ok {
+			rr.Name, _ = v.(string)
+		}
+		for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+			if v, ok := v.Attribute(k); ok {
+				if tags := tagsValue(v); len(tags) > 0 {
+					rr.Tags = tags
+					break
+				}
+			}
+		}
+	}
+
+	if rr.ARN == "" && arn.IsARN(rr.ID) {
+		rr.ARN = rr.ID
+	}
+
+	return &rr
+}
+
+func tagsValue(v any) map[string]string {
+	switch v := v.(type) {
+	case map[string]string:
+		return v
+	case map[string]any:
+		tags := make(map[string]string, len(v))
+		for k, v := range v {
+			if v, ok := v.(string); ok {
+				tags[k] = v
+			}
+		}
+		return tags
+	default:
+		return nil
+	}
+}
+
+// filters selects the resources to be swept.
+type filters struct {
+	namePattern *regexp.Regexp
+	tags        []TagFilter
+}
+
+// isSet returns whether any resource filter is set.
+func (f *filters) isSet() bool {
+	return f.namePattern != nil || len(f.tags) > 0
+}
+
+// match returns whether the described resource is to be swept.
+// If not, the reason is returned.
+func (f *filters) match(rr *ResourceReport) (bool, string) {
+	if f.namePattern != nil {
+		name := rr.Name
+		if name == "" {
+			name = rr.ID
+		}
+
+		if name == "" {
+			return false, "resource name and ID are unknown"
+		}
+
+		if !f.namePattern.MatchString(name) {
+			return false, fmt.Sprintf("name %q does not match %q", name, f.namePattern.String())
+		}
+	}
+
+	if len(f.tags) > 0 {
+		if rr.Tags == nil {
+			return false, "resource tags are unknown"
+		}
+
+		for _, tf := range f.tags {
+			v, ok := rr.Tags[tf.Key]
+			if !ok {
+				return false, fmt.Sprintf("tag %q is not present", tf.Key)
+			}
+
+			if len(tf.Values) > 0 && !slices.Contains(tf.Values, v) {
+				return false, fmt.Sprintf("tag %q value %q is not one of %q", tf.Key, v, tf.Values)
+			}
+		}
+	}
+
+	return true, ""
+}
+
+// TagsFunc returns the tags of the resources with the specified ARNs, indexed by ARN.
+// Resources without tags may be omitted.
+type TagsFunc func(ctx context.Context, client *conns.AWSClient, arns []string) (map[string]map[string]string, error)
+
+// findTags returns the tags of the resources with the specified ARNs using the Resource Groups Tagging API.
+func findTags(ctx context.Context, client *conns.AWSClient, arns []string) (map[string]map[string]string, error) {
+	const (
+		chunkSize = 100 // ResourceARNList maximum length.
+	)
+	conn := client.ResourceGroupsTaggingAPIClient(ctx)
+	output := make(map[string]map[string]string)
+
+	for chunk := range slices.Chunk(arns, chunkSize) {
+		input := resourcegroupstaggingapi.GetResourcesInput{
+			ResourceARNList: chunk,
+		}
+		pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, v := range page.ResourceTagMappingList {
+				tags := make(map[string]string, len(v.Tags))
+				for _, v := range v.Tags {
+					tags[aws.ToString(v.Key)] = aws.ToString(v.Value)
+				}
+				output[aws.ToString(v.ResourceARN)] = tags
+			}
+		}
+	}
+
+	return output, nil
+}
+
+// resolveTags looks up the tags of any described resources whose ARN, but not tags, are known.
+func resolveTags(ctx context.Context, client *conns.AWSClient, f TagsFunc, rrs []*ResourceReport) error {
+	var arns []string
+	for _, rr := range rrs {
+		if rr.Tags == nil && rr.ARN != "" {
+			arns = append(arns, rr.ARN)
+		}
+	}
+
+	if len(arns) == 0 {
+		return nil
+	}
+
+	tags, err := f(ctx, client, slices.Compact(slices.Sorted(slices.Values(arns))))
+	if err != nil {
+		return err
+	}
+
+	for _, rr := range rrs {
+		if rr.Tags == nil && rr.ARN != "" {
+			// Resources that have never been tagged, or whose service doesn't support the tagging API, are not returned.
+			if v, ok := tags[rr.ARN]; ok {
+				rr.Tags = maps.Clone(v)
+			}
+		}
+	}
+
+	return nil
+}
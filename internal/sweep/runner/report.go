@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package runner
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+)
+
+type SweeperStatus string
+
+const (
+	// The sweeper listed its resources and all selected resources were deleted (or would have been deleted).
+	SweeperStatusSucceeded SweeperStatus = "succeeded"
+	// The sweeper failed to list its resources or failed to delete one or more resources.
+	SweeperStatusFailed SweeperStatus = "failed"
+	// The sweeper was not run, for example because it is not supported in the Region or a dependency did not complete.
+	SweeperStatusSkipped SweeperStatus = "skipped"
+	// The sweeper cannot be run by the runner, because it is not registered or because it is a legacy sweeper and resource filters are set.
+	SweeperStatusUnsupported SweeperStatus = "unsupported"
+	// The sweeper is a legacy sweeper, which cannot list its resources without deleting them, and would be run.
+	SweeperStatusWouldRun SweeperStatus = "would_run"
+)
+
+type ResourceAction string
+
+const (
+	ResourceActionDeleted     ResourceAction = "deleted"
+	ResourceActionWouldDelete ResourceAction = "would_delete"
+	ResourceActionExcluded    ResourceAction = "excluded"
+	ResourceActionFailed      ResourceAction = "failed"
+)
+
+// Report records what a sweeper run deleted, or would have deleted.
+type Report struct {
+	DryRun    bool             `json:"dry_run"`
+	StartTime time.Time        `json:"start_time"`
+	EndTime   time.Time        `json:"end_time"`
+	Sweepers  []*SweeperReport `json:"sweepers"`
+}
+
+// SweeperReport records the result of running a single sweeper in a single Region.
+type SweeperReport struct {
+	Name      string            `json:"name"`
+	Region    string            `json:"region"`
+	Status    SweeperStatus     `json:"status"`
+	Reason    string            `json:"reason,omitempty"`
+	Resources []*ResourceReport `json:"resources,omitempty"`
+}
+
+// ResourceReport records what was done to a single resource listed by a sweeper.
+type ResourceReport struct {
+	ID     string            `json:"id,omitempty"`
+	ARN    string            `json:"arn,omitempty"`
+	Name   string            `json:"name,omitempty"`
+	Tags   map[string]string `json:"tags,omitempty"`
+	Action ResourceAction    `json:"action"`
+	Reason string            `json:"reason,omitempty"`
+}
+
+// HasFailures returns whether any sweeper failed.
+func (r *Report) HasFailures() bool {
+	for _, v := range r.Sweepers {
+		if v.Status == SweeperStatusFailed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Write writes the report as indented JSON.
+func (r *Report) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(r)
+}
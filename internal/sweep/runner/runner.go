@@ -0,0 +1,354 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package runner runs resource sweepers in dependency order, optionally without deleting anything.
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
+)
+
+const (
+	defaultParallelism = 4
+)
+
+// Options contains settings for a sweeper run.
+type Options struct {
+	// Regions are the AWS Regions to sweep. Regions are swept one at a time.
+	Regions []string
+	// Sweepers are the names of the sweepers to run. Their dependencies are also run.
+	// If empty, all registered sweepers are run.
+	Sweepers []string
+	// DryRun lists the resources that would be deleted without deleting them.
+	DryRun bool
+	// NamePattern, if set, selects resources whose name (or ID, if the name is unknown) matches.
+	NamePattern *regexp.Regexp
+	// Tags, if set, select resources with all the specified tags.
+	Tags []TagFilter
+	// Parallelism is the maximum number of sweepers run concurrently.
+	Parallelism int
+}
+
+// Runner runs registered sweepers.
+type Runner struct {
+	sweepers map[string]*sweep.Sweeper
+	clientFn func(ctx context.Context, region string) (*conns.AWSClient, error)
+	tagsFn   TagsFunc
+}
+
+// New returns a runner for the sweepers registered with `sweep.AddSweeper` or `sweep.AddTestSweepers`.
+func New() *Runner {
+	return &Runner{
+		sweepers: sweep.Sweepers(),
+		clientFn: sweep.SharedRegionalSweepClient,
+		tagsFn:   findTags,
+	}
+}
+
+// Run runs the selected sweepers in each Region and returns a report of what was (or would have been) deleted.
+// An error is returned if the run could not be started. Sweeper failures are recorded in the report.
+func (r *Runner) Run(ctx context.Context, opts Options) (*Report, error) {
+	if len(opts.Regions) == 0 {
+		return nil, errors.New("at least one Region must be specified")
+	}
+
+	if opts.Parallelism <= 0 {
+		opts.Parallelism = defaultParallelism
+	}
+
+	g, err := r.graph()
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(opts.Sweepers) > 0 {
+		selected := make(map[string]bool)
+		for _, name := range opts.Sweepers {
+			dependencies, err := g.DependenciesOf(name)
+			if err != nil {
+				return nil, fmt.Errorf("sweeper %q: %w", name, err)
+			}
+
+			selected[name] = true
+			for _, v := range dependencies {
+				selected[v] = true
+			}
+		}
+
+		order = slices.DeleteFunc(order, func(name string) bool {
+			return !selected[name]
+		})
+	}
+
+	report := &Report{
+		DryRun:    opts.DryRun,
+		StartTime: time.Now().UTC(),
+	}
+
+	f := &filters{
+		namePattern: opts.NamePattern,
+		tags:        opts.Tags,
+	}
+
+	for _, region := range opts.Regions {
+		report.Sweepers = append(report.Sweepers, r.runRegion(ctx, region, g, order, f, opts)...)
+	}
+
+	report.EndTime = time.Now().UTC()
+
+	return report, nil
+}
+
+// graph returns the dependency graph of all registered sweepers.
+// Dependencies that are not registered are included so that ordering is preserved.
+func (r *Runner) graph() (*depgraph.Graph, error) {
+	g := depgraph.New()
+
+	names := slices.Sorted(maps.Keys(r.sweepers))
+	for _, name := range names {
+		g.AddNode(name)
+		for _, v := range r.sweepers[name].Dependencies {
+			g.AddNode(v)
+		}
+	}
+
+	for _, name := range names {
+		for _, v := range r.sweepers[name].Dependencies {
+			if err := g.AddDependency(name, v); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// sweeperRun tracks a single sweeper's progress within a Region.
+type sweeperRun struct {
+	report *SweeperReport
+	done   chan struct{}
+	// incomplete indicates that the sweeper's dependents must not run. Written before `done` is closed.
+	incomplete bool
+}
+
+// runRegion runs the sweepers in the specified order in a single Region.
+// A sweeper starts once all of its dependencies have completed, so independent sweepers run concurrently.
+func (r *Runner) runRegion(ctx context.Context, region string, g *depgraph.Graph, order []string, f *filters, opts Options) []*SweeperReport {
+	runs := make(map[string]*sweeperRun, len(order))
+	reports := make([]*SweeperReport, len(order))
+	for i, name := range order {
+		reports[i] = &SweeperReport{
+			Name:   name,
+			Region: region,
+		}
+		runs[name] = &sweeperRun{
+			report: reports[i],
+			done:   make(chan struct{}),
+		}
+	}
+
+	ctx = log.Logger(ctx, "sweeper", region)
+
+	client, err := r.clientFn(ctx, region)
+	if err != nil {
+		for _, rpt := range reports {
+			rpt.Status = SweeperStatusFailed
+			rpt.Reason = fmt.Sprintf("getting client: %s", err)
+		}
+
+		return reports
+	}
+
+	sem := make(chan struct{}, opts.Parallelism)
+	var wg sync.WaitGroup
+
+	for _, name := range order {
+		run := runs[name]
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(run.done)
+
+			skip := func(reason string) {
+				run.report.Status = SweeperStatusSkipped
+				run.report.Reason = reason
+				run.incomplete = true
+			}
+
+			dependencies, _ := g.DirectDependenciesOf(name)
+			for _, v := range dependencies {
+				dep, ok := runs[v]
+				if !ok {
+					// Not selected.
+					continue
+				}
+
+				select {
+				case <-dep.done:
+				case <-ctx.Done():
+					skip(context.Cause(ctx).Error())
+					return
+				}
+
+				if dep.incomplete {
+					skip(fmt.Sprintf("dependency %q did not complete", v))
+					return
+				}
+			}
+
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				skip(context.Cause(ctx).Error())
+				return
+			}
+			defer func() { <-sem }()
+
+			r.runSweeper(ctx, client, run.report, f, opts.DryRun)
+
+			switch run.report.Status {
+			case SweeperStatusFailed, SweeperStatusUnsupported:
+				run.incomplete = true
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return reports
+}
+
+// runSweeper runs a single sweeper, recording the outcome in the specified report.
+func (r *Runner) runSweeper(ctx context.Context, client *conns.AWSClient, rpt *SweeperReport, f *filters, dryRun bool) {
+	s, ok := r.sweepers[rpt.Name]
+	if !ok {
+		rpt.Status = SweeperStatusUnsupported
+		rpt.Reason = "sweeper is not registered with the sweeper runner"
+		return
+	}
+
+	ctx = log.WithResourceType(ctx, rpt.Name)
+
+	if s.F == nil {
+		r.runLegacySweeper(ctx, s, rpt, f, dryRun)
+		return
+	}
+
+	tflog.Info(ctx, "listing resources")
+	sweepables, err := s.F(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+			"error": err.Error(),
+		})
+		rpt.Status = SweeperStatusSkipped
+		rpt.Reason = err.Error()
+		return
+	}
+
+	if err != nil {
+		rpt.Status = SweeperStatusFailed
+		rpt.Reason = fmt.Sprintf("listing resources: %s", err)
+		return
+	}
+
+	rpt.Resources = make([]*ResourceReport, len(sweepables))
+	for i, v := range sweepables {
+		rpt.Resources[i] = describe(v)
+	}
+
+	if len(f.tags) > 0 {
+		if err := resolveTags(ctx, client, r.tagsFn, rpt.Resources); err != nil {
+			rpt.Status = SweeperStatusFailed
+			rpt.Reason = fmt.Sprintf("reading resource tags: %s", err)
+			return
+		}
+	}
+
+	var wg sync.WaitGroup
+
+	for i, v := range sweepables {
+		rr := rpt.Resources[i]
+
+		if ok, reason := f.match(rr); !ok {
+			rr.Action = ResourceActionExcluded
+			rr.Reason = reason
+			continue
+		}
+
+		if dryRun {
+			rr.Action = ResourceActionWouldDelete
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := v.Delete(ctx); err != nil {
+				rr.Action = ResourceActionFailed
+				rr.Reason = err.Error()
+				return
+			}
+
+			rr.Action = ResourceActionDeleted
+		}()
+	}
+
+	wg.Wait()
+
+	if slices.ContainsFunc(rpt.Resources, func(rr *ResourceReport) bool {
+		return rr.Action == ResourceActionFailed
+	}) {
+		rpt.Status = SweeperStatusFailed
+		rpt.Reason = "deleting one or more resources failed"
+		return
+	}
+
+	rpt.Status = SweeperStatusSucceeded
+}
+
+// runLegacySweeper runs a sweeper registered with `sweep.AddTestSweepers`, recording the outcome in the specified report.
+// Legacy sweepers delete resources without listing them, so they cannot be run if resource filters are set and are not run in dry-run mode.
+func (r *Runner) runLegacySweeper(ctx context.Context, s *sweep.Sweeper, rpt *SweeperReport, f *filters, dryRun bool) {
+	if f.isSet() {
+		rpt.Status = SweeperStatusUnsupported
+		rpt.Reason = "legacy sweeper does not support resource filters"
+		return
+	}
+
+	if dryRun {
+		rpt.Status = SweeperStatusWouldRun
+		rpt.Reason = "legacy sweeper cannot list resources without deleting them"
+		return
+	}
+
+	tflog.Info(ctx, "running legacy sweeper")
+	if err := s.LegacyF(rpt.Region); err != nil {
+		rpt.Status = SweeperStatusFailed
+		rpt.Reason = err.Error()
+		return
+	}
+
+	rpt.Status = SweeperStatusSucceeded
+}
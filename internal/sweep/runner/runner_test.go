@@ -0,0 +1,397 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package runner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepable struct {
+	id         string
+	attributes map[string]any
+	err        error
+	deleted    *deleteLog
+	sweeper    string
+}
+
+func (s *testSweepable) ID() string {
+	return s.id
+}
+
+func (s *testSweepable) Attribute(name string) (any, bool) {
+	v, ok := s.attributes[name]
+	return v, ok
+}
+
+func (s *testSweepable) Delete(context.Context, ...tfresource.OptionsFunc) error {
+	if s.err != nil {
+		return s.err
+	}
+
+	s.deleted.add(s.sweeper + "/" + s.id)
+
+	return nil
+}
+
+// deleteLog records the order in which resources are deleted.
+type deleteLog struct {
+	lock sync.Mutex
+	ids  []string
+}
+
+func (l *deleteLog) add(id string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.ids = append(l.ids, id)
+}
+
+func testSweeper(name string, resources []*testSweepable, err error, dependencies ...string) *sweep.Sweeper {
+	return &sweep.Sweeper{
+		Name: name,
+		F: func(context.Context, *conns.AWSClient) ([]sweep.Sweepable, error) {
+			if err != nil {
+				return nil, err
+			}
+
+			var sweepables []sweep.Sweepable
+			for _, v := range resources {
+				v.sweeper = name
+				sweepables = append(sweepables, v)
+			}
+
+			return sweepables, nil
+		},
+		Dependencies: dependencies,
+	}
+}
+
+func testRunner(sweepers ...*sweep.Sweeper) *Runner {
+	r := &Runner{
+		sweepers: make(map[string]*sweep.Sweeper),
+		clientFn: func(context.Context, string) (*conns.AWSClient, error) {
+			return &conns.AWSClient{}, nil
+		},
+		tagsFn: func(context.Context, *conns.AWSClient, []string) (map[string]map[string]string, error) {
+			return map[string]map[string]string{
+				"arn:aws:sqs:us-west-2:123456789012:tagged": {"Owner": "sandbox"}, //lintignore:AWSAT003,AWSAT005
+			}, nil
+		},
+	}
+
+	for _, v := range sweepers {
+		r.sweepers[v.Name] = v
+	}
+
+	return r
+}
+
+func statuses(report *Report) map[string]SweeperStatus {
+	m := make(map[string]SweeperStatus)
+	for _, v := range report.Sweepers {
+		m[v.Name] = v.Status
+	}
+
+	return m
+}
+
+func TestRunner_dryRun(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	deleted := &deleteLog{}
+	r := testRunner(
+		testSweeper("aws_example_parent", []*testSweepable{{id: "p1", deleted: deleted}}, nil, "aws_example_child"),
+		testSweeper("aws_example_child", []*testSweepable{{id: "c1", deleted: deleted}, {id: "c2", deleted: deleted}}, nil),
+	)
+
+	report, err := r.Run(ctx, Options{
+		Regions: []string{"us-west-2"}, //lintignore:AWSAT003
+		DryRun:  true,
+	})
+	if err != nil {
+		t.Fatalf("Run: %s", err)
+	}
+
+	if len(deleted.ids) != 0 {
+		t.Errorf("resources deleted during dry run: %v", deleted.ids)
+	}
+
+	if got, want := []string{report.Sweepers[0].Name, report.Sweepers[1].Name}, []string{"aws_example_child", "aws_example_parent"}; !slices.Equal(got, want) {
+		t.Errorf("order = %v, want %v", got, want)
+	}
+
+	for _, v := range report.Sweepers {
+		if v.Status != SweeperStatusSucceeded {
+			t.Errorf("sweeper %s status = %s, want %s", v.Name, v.Status, SweeperStatusSucceeded)
+		}
+		for _, rr := range v.Resources {
+			if rr.Action != ResourceActionWouldDelete {
+				t.Errorf("resource %s action = %s, want %s", rr.ID, rr.Action, ResourceActionWouldDelete)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := report.Write(&buf); err != nil {
+		t.Fatalf("Write: %s", err)
+	}
+
+	var got Report
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("unmarshaling report: %s", err)
+	}
+	if !got.DryRun || len(got.Sweepers) != 2 {
+		t.Errorf("unexpected report: %s", buf.String())
+	}
+}
+
+func TestRunner_dependencyOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	deleted := &deleteLog{}
+	r := testRunner(
+		testSweeper("aws_example_a", []*testSweepable{{id: "a", deleted: deleted}}, nil, "aws_example_b", "aws_example_c"),
+		testSweeper("aws_example_b", []*testSweepable{{id: "b", deleted: deleted}}, nil, "aws_example_d"),
+		testSweeper("aws_example_c", []*testSweepable{{id: "c", deleted: deleted}}, nil, "aws_example_d"),
+		testSweeper("aws_example_d", []*testSweepable{{id: "d", deleted: deleted}}, nil),
+	)
+
+	if _, err := r.Run(ctx, Options{
+		Regions:     []string{"us-west-2"}, //lintignore:AWSAT003
+		Parallelism: 2,
+	}); err != nil {
+		t.Fatalf("Run: %s", err)
+	}
+
+	if got, want := len(deleted.ids), 4; got != want {
+		t.Fatalf("deleted %d resources, want %d", got, want)
+	}
+
+	index := func(id string) int {
+		return slices.Index(deleted.ids, id)
+	}
+	for _, v := range [][2]string{
+		{"aws_example_d/d", "aws_example_b/b"},
+		{"aws_example_d/d", "aws_example_c/c"},
+		{"aws_example_b/b", "aws_example_a/a"},
+		{"aws_example_c/c", "aws_example_a/a"},
+	} {
+		if index(v[0]) > index(v[1]) {
+			t.Errorf("%s deleted after %s: %v", v[0], v[1], deleted.ids)
+		}
+	}
+}
+
+func TestRunner_selectedSweepers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := testRunner(
+		testSweeper("aws_example_a", nil, nil, "aws_example_b"),
+		testSweeper("aws_example_b", nil, nil),
+		testSweeper("aws_example_c", nil, nil),
+	)
+
+	report, err := r.Run(ctx, Options{
+		Regions:  []string{"us-west-2"}, //lintignore:AWSAT003
+		Sweepers: []string{"aws_example_a"},
+		DryRun:   true,
+	})
+	if err != nil {
+		t.Fatalf("Run: %s", err)
+	}
+
+	want := map[string]SweeperStatus{
+		"aws_example_a": SweeperStatusSucceeded,
+		"aws_example_b": SweeperStatusSucceeded,
+	}
+	if diff := cmp.Diff(want, statuses(report)); diff != "" {
+		t.Errorf("unexpected statuses (-want +got): %s", diff)
+	}
+
+	if _, err := r.Run(ctx, Options{
+		Regions:  []string{"us-west-2"}, //lintignore:AWSAT003
+		Sweepers: []string{"aws_example_unknown"},
+	}); err == nil {
+		t.Error("expected error for unknown sweeper, got none")
+	}
+}
+
+func TestRunner_failures(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	deleted := &deleteLog{}
+	r := testRunner(
+		testSweeper("aws_example_a", []*testSweepable{{id: "a", deleted: deleted}}, nil, "aws_example_b"),
+		testSweeper("aws_example_b", []*testSweepable{{id: "b", err: errors.New("in use"), deleted: deleted}}, nil),
+		testSweeper("aws_example_c", []*testSweepable{{id: "c", deleted: deleted}}, nil, "aws_example_legacy"),
+		testSweeper("aws_example_d", nil, errors.New("AccessDeniedException: not available")),
+		testSweeper("aws_example_e", []*testSweepable{{id: "e", deleted: deleted}}, nil),
+	)
+
+	report, err := r.Run(ctx, Options{
+		Regions: []string{"us-west-2"}, //lintignore:AWSAT003
+	})
+	if err != nil {
+		t.Fatalf("Run: %s", err)
+	}
+
+	want := map[string]SweeperStatus{
+		"aws_example_a":      SweeperStatusSkipped,
+		"aws_example_b":      SweeperStatusFailed,
+		"aws_example_c":      SweeperStatusSkipped,
+		"aws_example_d":      SweeperStatusFailed,
+		"aws_example_e":      SweeperStatusSucceeded,
+		"aws_example_legacy": SweeperStatusUnsupported,
+	}
+	if diff := cmp.Diff(want, statuses(report)); diff != "" {
+		t.Errorf("unexpected statuses (-want +got): %s", diff)
+	}
+
+	if !report.HasFailures() {
+		t.Error("expected report to have failures")
+	}
+
+	if got, want := deleted.ids, []string{"aws_example_e/e"}; !slices.Equal(got, want) {
+		t.Errorf("deleted = %v, want %v", got, want)
+	}
+}
+
+func TestRunner_legacy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		opts      Options
+		legacyErr error
+		want      map[string]SweeperStatus
+		wantRun   []string
+		wantIDs   []string
+	}{
+		"dry run": {
+			opts: Options{DryRun: true},
+			want: map[string]SweeperStatus{
+				"aws_example_legacy": SweeperStatusWouldRun,
+				"aws_example_parent": SweeperStatusSucceeded,
+			},
+		},
+		"run": {
+			want: map[string]SweeperStatus{
+				"aws_example_legacy": SweeperStatusSucceeded,
+				"aws_example_parent": SweeperStatusSucceeded,
+			},
+			wantRun: []string{"us-west-2"}, //lintignore:AWSAT003
+			wantIDs: []string{"aws_example_parent/p"},
+		},
+		"failed": {
+			legacyErr: errors.New("in use"),
+			want: map[string]SweeperStatus{
+				"aws_example_legacy": SweeperStatusFailed,
+				"aws_example_parent": SweeperStatusSkipped,
+			},
+			wantRun: []string{"us-west-2"}, //lintignore:AWSAT003
+		},
+		"filtered": {
+			opts: Options{NamePattern: regexp.MustCompile(`^p$`)},
+			want: map[string]SweeperStatus{
+				"aws_example_legacy": SweeperStatusUnsupported,
+				"aws_example_parent": SweeperStatusSkipped,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var run []string
+			deleted := &deleteLog{}
+			r := testRunner(
+				testSweeper("aws_example_parent", []*testSweepable{{id: "p", deleted: deleted}}, nil, "aws_example_legacy"),
+				&sweep.Sweeper{
+					Name: "aws_example_legacy",
+					LegacyF: func(region string) error {
+						run = append(run, region)
+						return tc.legacyErr
+					},
+				},
+			)
+
+			tc.opts.Regions = []string{"us-west-2"} //lintignore:AWSAT003
+			report, err := r.Run(ctx, tc.opts)
+			if err != nil {
+				t.Fatalf("Run: %s", err)
+			}
+
+			if diff := cmp.Diff(tc.want, statuses(report)); diff != "" {
+				t.Errorf("unexpected statuses (-want +got): %s", diff)
+			}
+
+			if !slices.Equal(run, tc.wantRun) {
+				t.Errorf("legacy sweeper run in %v, want %v", run, tc.wantRun)
+			}
+
+			if !slices.Equal(deleted.ids, tc.wantIDs) {
+				t.Errorf("deleted = %v, want %v", deleted.ids, tc.wantIDs)
+			}
+		})
+	}
+}
+
+func TestRunner_filters(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	deleted := &deleteLog{}
+	r := testRunner(
+		testSweeper("aws_example_thing", []*testSweepable{
+			{id: "tf-acc-test-1", deleted: deleted, attributes: map[string]any{"tags": map[string]any{"Owner": "sandbox"}}},
+			{id: "tf-acc-test-2", deleted: deleted, attributes: map[string]any{"tags": map[string]any{"Owner": "production"}}},
+			{id: "tf-acc-test-3", deleted: deleted},
+			{id: "arn:aws:sqs:us-west-2:123456789012:tagged", deleted: deleted, attributes: map[string]any{"name": "tf-acc-test-4"}}, //lintignore:AWSAT003,AWSAT005
+			{id: "other", deleted: deleted, attributes: map[string]any{"tags": map[string]any{"Owner": "sandbox"}}},
+		}, nil),
+	)
+
+	report, err := r.Run(ctx, Options{
+		Regions:     []string{"us-west-2"}, //lintignore:AWSAT003
+		NamePattern: regexp.MustCompile(`^tf-acc-test-`),
+		Tags:        []TagFilter{{Key: "Owner", Values: []string{"sandbox"}}},
+	})
+	if err != nil {
+		t.Fatalf("Run: %s", err)
+	}
+
+	actions := make(map[string]ResourceAction)
+	for _, v := range report.Sweepers[0].Resources {
+		actions[v.ID] = v.Action
+	}
+
+	want := map[string]ResourceAction{
+		"tf-acc-test-1": ResourceActionDeleted,
+		"tf-acc-test-2": ResourceActionExcluded,
+		"tf-acc-test-3": ResourceActionExcluded,
+		"arn:aws:sqs:us-west-2:123456789012:tagged": ResourceActionDeleted, //lintignore:AWSAT003,AWSAT005
+		"other": ResourceActionExcluded,
+	}
+	if diff := cmp.Diff(want, actions); diff != "" {
+		t.Errorf("unexpected actions (-want +got): %s", diff)
+	}
+}
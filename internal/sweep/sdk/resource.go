@@ -39,6 +39,20 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return deleteResource(ctx, sr.resource, sr.d, sr.meta)
 }
 
+// ID returns the ID of the resource to be swept.
+func (sr *sweepResource) ID() string {
+	return sr.d.Id()
+}
+
+// Attribute returns the value of the specified top-level attribute of the resource to be swept, if it has been set.
+func (sr *sweepResource) Attribute(name string) (any, bool) {
+	if _, ok := sr.resource.SchemaMap()[name]; !ok {
+		return nil, false
+	}
+
+	return sr.d.GetOk(name)
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
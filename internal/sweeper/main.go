@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// sweeper runs acceptance test resource sweepers in dependency order and writes a JSON report
+// of the resources that were, or in dry-run mode would be, deleted.
+//
+// Usage:
+//
+//	go run ./internal/sweeper -regions=us-west-2,us-east-1 [flags]
+//
+// Resources are not deleted unless -dry-run=false is specified.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/runner"
+)
+
+// tagFlag accumulates -tag flag values.
+type tagFlag []runner.TagFilter
+
+func (f *tagFlag) String() string {
+	return fmt.Sprint(*f)
+}
+
+func (f *tagFlag) Set(s string) error {
+	key, value, hasValue := strings.Cut(s, "=")
+	if key == "" {
+		return fmt.Errorf("invalid tag filter %q: key must not be empty", s)
+	}
+
+	for i, v := range *f {
+		if v.Key == key {
+			if hasValue {
+				(*f)[i].Values = append((*f)[i].Values, value)
+			}
+			return nil
+		}
+	}
+
+	filter := runner.TagFilter{Key: key}
+	if hasValue {
+		filter.Values = []string{value}
+	}
+	*f = append(*f, filter)
+
+	return nil
+}
+
+func main() {
+	os.Exit(run())
+}
+
+func run() int {
+	var (
+		dryRun      = flag.Bool("dry-run", true, "List the resources that would be deleted without deleting them")
+		namePattern = flag.String("name-pattern", "", "Only sweep resources whose name (or ID, if the name is unknown) matches this regular expression")
+		parallelism = flag.Int("parallelism", 4, "Maximum number of sweepers to run concurrently")
+		regions     = flag.String("regions", "", "Comma-separated list of AWS Regions to sweep (required)")
+		reportPath  = flag.String("report", "", "Path of the JSON report file. Defaults to standard output")
+		sweepers    = flag.String("sweepers", "", "Comma-separated list of sweepers to run, with their dependencies. Defaults to all sweepers")
+		tags        tagFlag
+	)
+	flag.Var(&tags, "tag", "Only sweep resources with this tag, as `Key` or `Key=Value`. May be repeated; values for the same key are alternatives")
+	flag.Parse()
+
+	if *regions == "" {
+		fmt.Fprintln(os.Stderr, "-regions is required")
+		flag.Usage()
+		return 2
+	}
+
+	opts := runner.Options{
+		Regions:     strings.Split(*regions, ","),
+		DryRun:      *dryRun,
+		Tags:        tags,
+		Parallelism: *parallelism,
+	}
+
+	if *sweepers != "" {
+		opts.Sweepers = strings.Split(*sweepers, ",")
+	}
+
+	if *namePattern != "" {
+		re, err := regexp.Compile(*namePattern)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "-name-pattern: %s\n", err)
+			return 2
+		}
+		opts.NamePattern = re
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	ctx = tfsdklog.ContextWithStandardLogging(ctx, "sweeper")
+
+	sweep.ServicePackages = servicePackages(ctx)
+	registerSweepers()
+
+	if !opts.DryRun {
+		fmt.Fprintln(os.Stderr, "WARNING: This will destroy infrastructure. Use only in development accounts.")
+	}
+
+	report, err := runner.New().Run(ctx, opts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "running sweepers: %s\n", err)
+		return 1
+	}
+
+	var w io.Writer = os.Stdout
+	if *reportPath != "" {
+		f, err := os.Create(*reportPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "creating report: %s\n", err)
+			return 1
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if err := report.Write(w); err != nil {
+		fmt.Fprintf(os.Stderr, "writing report: %s\n", err)
+		return 1
+	}
+
+	if report.HasFailures() {
+		return 1
+	}
+
+	return 0
+}
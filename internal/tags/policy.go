@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+type PolicySeverity string
+
+const (
+	PolicySeverityError   PolicySeverity = "error"
+	PolicySeverityWarning PolicySeverity = "warning"
+)
+
+func (PolicySeverity) Values() []PolicySeverity {
+	return []PolicySeverity{
+		PolicySeverityError,
+		PolicySeverityWarning,
+	}
+}
+
+// PolicyConfig contains settings to validate resource tags against a tag policy.
+type PolicyConfig struct {
+	// Keys holds the policy's rules indexed by lower-cased tag key.
+	Keys     map[string]*PolicyKey
+	Severity PolicySeverity
+	// UseEffectivePolicy indicates that the account's effective AWS Organizations tag policy is to be merged into the configuration.
+	UseEffectivePolicy bool
+}
+
+// PolicyKey contains the rules for a single tag key.
+type PolicyKey struct {
+	// Key is the tag key with the expected capitalization.
+	Key      string
+	Required bool
+	// ValuePatterns are regular expressions that the tag's value must all match.
+	ValuePatterns []*regexp.Regexp
+}
+
+// NewPolicyConfig returns a tag policy configuration built from required tag keys and per-key value patterns.
+func NewPolicyConfig(requiredKeys []string, valuePatterns map[string]string, severity PolicySeverity) (*PolicyConfig, error) {
+	pc := &PolicyConfig{
+		Keys:     make(map[string]*PolicyKey),
+		Severity: severity,
+	}
+
+	if pc.Severity == "" {
+		pc.Severity = PolicySeverityError
+	}
+
+	for _, k := range requiredKeys {
+		pc.key(k).Required = true
+	}
+
+	for k, v := range valuePatterns {
+		re, err := regexp.Compile(v)
+		if err != nil {
+			return nil, fmt.Errorf("compiling value pattern for tag key %q: %w", k, err)
+		}
+
+		pk := pc.key(k)
+		pk.ValuePatterns = append(pk.ValuePatterns, re)
+	}
+
+	return pc, nil
+}
+
+func (pc *PolicyConfig) key(k string) *PolicyKey {
+	lk := strings.ToLower(k)
+
+	pk, ok := pc.Keys[lk]
+	if !ok {
+		pk = &PolicyKey{Key: k}
+		pc.Keys[lk] = pk
+	}
+
+	return pk
+}
+
+// IsWarning returns whether tag policy violations are to be reported as warnings.
+func (pc *PolicyConfig) IsWarning() bool {
+	return pc != nil && pc.Severity == PolicySeverityWarning
+}
+
+// MergeEffectivePolicy merges the contents of an effective AWS Organizations tag policy into the configuration.
+// Policy keys define the expected tag key capitalization and any allowed values.
+func (pc *PolicyConfig) MergeEffectivePolicy(content string) error {
+	var policy struct {
+		Tags map[string]struct {
+			TagKey   json.RawMessage `json:"tag_key"`
+			TagValue json.RawMessage `json:"tag_value"`
+		} `json:"tags"`
+	}
+
+	if err := json.Unmarshal([]byte(content), &policy); err != nil {
+		return fmt.Errorf("parsing effective tag policy: %w", err)
+	}
+
+	for name, v := range policy.Tags {
+		var key string
+		if err := unmarshalPolicyValue(v.TagKey, &key); err != nil {
+			return fmt.Errorf("parsing effective tag policy key %q: %w", name, err)
+		}
+		if key == "" {
+			key = name
+		}
+
+		pk := pc.key(key)
+		// The Organizations policy is authoritative for capitalization.
+		pk.Key = key
+
+		if len(v.TagValue) == 0 {
+			continue
+		}
+
+		var values []string
+		if err := unmarshalPolicyValue(v.TagValue, &values); err != nil {
+			return fmt.Errorf("parsing effective tag policy values for key %q: %w", key, err)
+		}
+		if len(values) == 0 {
+			continue
+		}
+
+		alternatives := make([]string, 0, len(values))
+		for _, value := range values {
+			parts := strings.Split(value, "*")
+			for i, part := range parts {
+				parts[i] = regexp.QuoteMeta(part)
+			}
+			alternatives = append(alternatives, strings.Join(parts, ".*"))
+		}
+
+		pk.ValuePatterns = append(pk.ValuePatterns, regexp.MustCompile(`^(?:`+strings.Join(alternatives, "|")+`)$`))
+	}
+
+	return nil
+}
+
+// unmarshalPolicyValue unmarshals a policy value, unwrapping any "@@assign" inheritance operator.
+func unmarshalPolicyValue(data json.RawMessage, v any) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	var operators map[string]json.RawMessage
+	if err := json.Unmarshal(data, &operators); err == nil {
+		if assign, ok := operators["@@assign"]; ok {
+			data = assign
+		}
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// Validate returns an error describing all the ways in which the specified tags violate the policy.
+// Returns nil if the tags are compliant.
+func (pc *PolicyConfig) Validate(tags KeyValueTags) error {
+	if pc == nil || len(pc.Keys) == 0 {
+		return nil
+	}
+
+	lowerKeys := make(map[string]string, len(tags))
+	for k := range tags {
+		lowerKeys[strings.ToLower(k)] = k
+	}
+
+	var errs []error
+
+	for _, lk := range slices.Sorted(maps.Keys(pc.Keys)) {
+		pk := pc.Keys[lk]
+
+		k, ok := lowerKeys[lk]
+		if !ok {
+			if pk.Required {
+				errs = append(errs, fmt.Errorf("required tag %q is missing", pk.Key))
+			}
+
+			continue
+		}
+
+		if k != pk.Key {
+			errs = append(errs, fmt.Errorf("tag key %q does not match the policy's capitalization %q", k, pk.Key))
+		}
+
+		value := tags.KeyTagData(k).ValueString()
+		for _, re := range pk.ValuePatterns {
+			if !re.MatchString(value) {
+				errs = append(errs, fmt.Errorf("tag %q value %q does not match %q", k, value, re.String()))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPolicyConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name          string
+		requiredKeys  []string
+		valuePatterns map[string]string
+		tags          KeyValueTags
+		wantErr       bool
+	}{
+		{
+			name:    "no rules",
+			tags:    New(ctx, map[string]string{}),
+			wantErr: false,
+		},
+		{
+			name:         "required key present",
+			requiredKeys: []string{"CostCenter"},
+			tags: New(ctx, map[string]string{
+				"CostCenter": "1234",
+			}),
+			wantErr: false,
+		},
+		{
+			name:         "required key missing",
+			requiredKeys: []string{"CostCenter"},
+			tags: New(ctx, map[string]string{
+				"Owner": "team",
+			}),
+			wantErr: true,
+		},
+		{
+			name:         "required key wrong capitalization",
+			requiredKeys: []string{"CostCenter"},
+			tags: New(ctx, map[string]string{
+				"costcenter": "1234",
+			}),
+			wantErr: true,
+		},
+		{
+			name: "value matches pattern",
+			valuePatterns: map[string]string{
+				"CostCenter": `^[0-9]{4}$`,
+			},
+			tags: New(ctx, map[string]string{
+				"CostCenter": "1234",
+			}),
+			wantErr: false,
+		},
+		{
+			name: "value does not match pattern",
+			valuePatterns: map[string]string{
+				"CostCenter": `^[0-9]{4}$`,
+			},
+			tags: New(ctx, map[string]string{
+				"CostCenter": "abcd",
+			}),
+			wantErr: true,
+		},
+		{
+			name: "optional key absent",
+			valuePatterns: map[string]string{
+				"CostCenter": `^[0-9]{4}$`,
+			},
+			tags:    New(ctx, map[string]string{}),
+			wantErr: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			policyConfig, err := NewPolicyConfig(testCase.requiredKeys, testCase.valuePatterns, PolicySeverityError)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			err = policyConfig.Validate(testCase.tags)
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("Validate() error = %v, wantErr %t", err, want)
+			}
+		})
+	}
+}
+
+func TestPolicyConfigValidate_nilConfig(t *testing.T) {
+	t.Parallel()
+
+	var policyConfig *PolicyConfig
+
+	if err := policyConfig.Validate(New(context.Background(), map[string]string{})); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestNewPolicyConfig_invalidPattern(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewPolicyConfig(nil, map[string]string{"CostCenter": `[`}, PolicySeverityError); err == nil {
+		t.Error("expected error, got none")
+	}
+}
+
+func TestPolicyConfigMergeEffectivePolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const content = `{
+  "tags": {
+    "costcenter": {
+      "tag_key": "CostCenter",
+      "tag_value": ["100", "200", "300*"],
+      "enforced_for": ["ec2:instance"]
+    },
+    "project": {
+      "tag_key": {
+        "@@assign": "Project"
+      }
+    }
+  }
+}`
+
+	testCases := []struct {
+		name    string
+		tags    KeyValueTags
+		wantErr bool
+	}{
+		{
+			name:    "no tags",
+			tags:    New(ctx, map[string]string{}),
+			wantErr: false,
+		},
+		{
+			name: "allowed value",
+			tags: New(ctx, map[string]string{
+				"CostCenter": "200",
+			}),
+			wantErr: false,
+		},
+		{
+			name: "allowed wildcard value",
+			tags: New(ctx, map[string]string{
+				"CostCenter": "300-eu",
+			}),
+			wantErr: false,
+		},
+		{
+			name: "disallowed value",
+			tags: New(ctx, map[string]string{
+				"CostCenter": "400",
+			}),
+			wantErr: true,
+		},
+		{
+			name: "wrong capitalization",
+			tags: New(ctx, map[string]string{
+				"PROJECT": "example",
+			}),
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			policyConfig, err := NewPolicyConfig(nil, nil, PolicySeverityWarning)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err := policyConfig.MergeEffectivePolicy(content); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			err = policyConfig.Validate(testCase.tags)
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("Validate() error = %v, wantErr %t", err, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import "testing"
+
+func TestIsAWSRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	for _, tc := range []struct {
+		id    string
+		valid bool
+	}{
+		{"us-west-2", true},      //lintignore:AWSAT003
+		{"us-gov-east-1", true},  //lintignore:AWSAT003
+		{"cn-northwest-1", true}, //lintignore:AWSAT003
+		{"eu-central-1", true},   //lintignore:AWSAT003
+		{"us-west", false},
+		{"uswest2", false},
+		{"", false},
+		{"US-WEST-2", false},
+		{"us-west-2a", false},
+	} {
+		ok := IsAWSRegion(tc.id)
+		if got, want := ok, tc.valid; got != want {
+			t.Errorf("IsAWSRegion(%q) = %v, want %v", tc.id, got, want)
+		}
+	}
+}
@@ -8,8 +8,10 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // ServicePackageResourceTags represents resource-level tagging information.
@@ -18,12 +20,68 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceRegion represents resource-level Region information.
+type ServicePackageResourceRegion struct {
+	IsOverrideEnabled             bool // Is per-resource Region override supported?
+	IsValidateOverrideInPartition bool // Is the per-resource Region override value validated against the configured partition?
+}
+
+// ResourceRegionDefault returns the default resource-level Region information.
+// Per-resource Region override is supported and the override value is validated against the configured partition.
+func ResourceRegionDefault() *ServicePackageResourceRegion {
+	return &ServicePackageResourceRegion{
+		IsOverrideEnabled:             true,
+		IsValidateOverrideInPartition: true,
+	}
+}
+
+// ServicePackageResourceIdentity represents resource-level identity information.
+// A resource's identity is the set of attributes that uniquely identify the resource in AWS.
+type ServicePackageResourceIdentity struct {
+	IsGlobalResource  bool   // Is the resource global (no Region)?
+	IsARN             bool   // Is the resource identified by its ARN?
+	IdentityAttribute string // The natural key attribute that, with AWS account ID and Region, identifies the resource.
+}
+
+// RegionalSingleParameterIdentity returns the identity information for a Regional resource identified by a single attribute.
+func RegionalSingleParameterIdentity(name string) *ServicePackageResourceIdentity {
+	return &ServicePackageResourceIdentity{
+		IdentityAttribute: name,
+	}
+}
+
+// GlobalSingleParameterIdentity returns the identity information for a global resource identified by a single attribute.
+func GlobalSingleParameterIdentity(name string) *ServicePackageResourceIdentity {
+	return &ServicePackageResourceIdentity{
+		IsGlobalResource:  true,
+		IdentityAttribute: name,
+	}
+}
+
+// RegionalARNIdentity returns the identity information for a Regional resource identified by its ARN.
+func RegionalARNIdentity() *ServicePackageResourceIdentity {
+	return &ServicePackageResourceIdentity{
+		IsARN:             true,
+		IdentityAttribute: names.AttrARN,
+	}
+}
+
+// GlobalARNIdentity returns the identity information for a global resource identified by its ARN.
+func GlobalARNIdentity() *ServicePackageResourceIdentity {
+	return &ServicePackageResourceIdentity{
+		IsGlobalResource:  true,
+		IsARN:             true,
+		IdentityAttribute: names.AttrARN,
+	}
+}
+
 // ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
 // implemented by a service package.
 type ServicePackageEphemeralResource struct {
 	Factory  func(context.Context) (ephemeral.EphemeralResourceWithConfigure, error)
 	TypeName string
 	Name     string
+	Region   *ServicePackageResourceRegion
 }
 
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
@@ -32,6 +90,7 @@ type ServicePackageFrameworkDataSource struct {
 	Factory  func(context.Context) (datasource.DataSourceWithConfigure, error)
 	TypeName string
 	Name     string
+	Region   *ServicePackageResourceRegion
 	Tags     *ServicePackageResourceTags
 }
 
@@ -41,6 +100,8 @@ type ServicePackageFrameworkResource struct {
 	Factory  func(context.Context) (resource.ResourceWithConfigure, error)
 	TypeName string
 	Name     string
+	Region   *ServicePackageResourceRegion
+	Identity *ServicePackageResourceIdentity
 	Tags     *ServicePackageResourceTags
 }
 
@@ -50,6 +111,7 @@ type ServicePackageSDKDataSource struct {
 	Factory  func() *schema.Resource
 	TypeName string
 	Name     string
+	Region   *ServicePackageResourceRegion
 	Tags     *ServicePackageResourceTags
 }
 
@@ -59,5 +121,24 @@ type ServicePackageSDKResource struct {
 	Factory  func() *schema.Resource
 	TypeName string
 	Name     string
+	Region   *ServicePackageResourceRegion
+	Identity *ServicePackageResourceIdentity
 	Tags     *ServicePackageResourceTags
 }
+
+// ListResourceForSDK is implemented by Terraform Plugin Framework list resources
+// that list instances of a Terraform Plugin SDK resource.
+type ListResourceForSDK interface {
+	list.ListResourceWithConfigure
+	list.ListResourceWithRawV5Schemas
+	SetResourceSchema(*schema.Resource)
+}
+
+// ServicePackageSDKListResource represents a list resource for a Terraform Plugin SDK resource
+// implemented by a service package.
+type ServicePackageSDKListResource struct {
+	Factory  func() ListResourceForSDK
+	TypeName string
+	Name     string
+	Region   *ServicePackageResourceRegion
+}
@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// PolicyNormalize returns an IAM policy document as canonical, minified JSON.
+// Statements are sorted; Action, Resource, Principal and Condition values are sorted
+// and de-duplicated and single-element lists are collapsed, so semantically
+// equivalent policies produce identical output.
+func PolicyNormalize(policy string) (string, error) {
+	doc, err := decodeIAMPolicy(policy)
+	if err != nil {
+		return "", err
+	}
+
+	return doc.canonicalString()
+}
+
+// PolicyMerge merges IAM policy documents in order and returns the result as canonical, minified JSON.
+// A statement whose Sid matches a statement in an earlier document replaces it.
+func PolicyMerge(policies ...string) (string, error) {
+	merged := &iamPolicyDocument{}
+
+	for i, policy := range policies {
+		doc, err := decodeIAMPolicy(policy)
+		if err != nil {
+			return "", fmt.Errorf("policy %d: %w", i, err)
+		}
+
+		merged.merge(doc)
+	}
+
+	return merged.canonicalString()
+}
+
+type iamPolicyDocument struct {
+	Version   string              `json:",omitempty"`
+	Id        string              `json:",omitempty"`
+	Statement iamPolicyStatements `json:",omitempty"`
+}
+
+type iamPolicyStatement struct {
+	Sid          string                                 `json:",omitempty"`
+	Effect       string                                 `json:",omitempty"`
+	Action       iamPolicyStrings                       `json:",omitempty"`
+	NotAction    iamPolicyStrings                       `json:",omitempty"`
+	Resource     iamPolicyStrings                       `json:",omitempty"`
+	NotResource  iamPolicyStrings                       `json:",omitempty"`
+	Principal    iamPolicyPrincipal                     `json:",omitempty"`
+	NotPrincipal iamPolicyPrincipal                     `json:",omitempty"`
+	Condition    map[string]map[string]iamPolicyStrings `json:",omitempty"`
+}
+
+// iamPolicyStatements is a list of statements, which may be written as a single statement object.
+type iamPolicyStatements []*iamPolicyStatement
+
+func (s *iamPolicyStatements) UnmarshalJSON(b []byte) error {
+	var statements []*iamPolicyStatement
+
+	if b = bytes.TrimSpace(b); len(b) > 0 && b[0] == '{' {
+		var statement iamPolicyStatement
+		if err := unmarshalIAMPolicyJSON(b, &statement); err != nil {
+			return err
+		}
+		statements = append(statements, &statement)
+	} else if err := unmarshalIAMPolicyJSON(b, &statements); err != nil {
+		return err
+	}
+
+	for i, statement := range statements {
+		if statement == nil {
+			return fmt.Errorf("Statement %d: statement is null", i)
+		}
+	}
+
+	*s = statements
+
+	return nil
+}
+
+// iamPolicyStrings is a list of strings, which may be written as a single value.
+// Boolean and numeric values, which are valid in Condition blocks, are converted to strings.
+type iamPolicyStrings []string
+
+func (s *iamPolicyStrings) UnmarshalJSON(b []byte) error {
+	var v any
+	if err := unmarshalIAMPolicyJSON(b, &v); err != nil {
+		return err
+	}
+
+	var values []string
+	switch v := v.(type) {
+	case nil:
+	case []any:
+		for _, v := range v {
+			value, err := iamPolicyScalarString(v)
+			if err != nil {
+				return err
+			}
+			values = append(values, value)
+		}
+	default:
+		value, err := iamPolicyScalarString(v)
+		if err != nil {
+			return err
+		}
+		values = append(values, value)
+	}
+
+	*s = values
+
+	return nil
+}
+
+func (s iamPolicyStrings) MarshalJSON() ([]byte, error) {
+	switch len(s) {
+	case 0:
+		return []byte("[]"), nil
+	case 1:
+		return json.Marshal(s[0])
+	default:
+		return json.Marshal([]string(s))
+	}
+}
+
+func iamPolicyScalarString(v any) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case json.Number:
+		if f, err := v.Float64(); err == nil {
+			return strconv.FormatFloat(f, 'f', -1, 64), nil
+		}
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("unsupported value type %T", v)
+	}
+}
+
+// iamPolicyPrincipal maps principal types to identifiers.
+// The wildcard principal "*" is equivalent to {"*": "*"}.
+type iamPolicyPrincipal map[string]iamPolicyStrings
+
+func (p *iamPolicyPrincipal) UnmarshalJSON(b []byte) error {
+	if string(bytes.TrimSpace(b)) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		if s != "*" {
+			return fmt.Errorf("unsupported principal %q", s)
+		}
+		*p = iamPolicyPrincipal{"*": {"*"}}
+		return nil
+	}
+
+	var principal map[string]iamPolicyStrings
+	if err := unmarshalIAMPolicyJSON(b, &principal); err != nil {
+		return err
+	}
+
+	*p = principal
+
+	return nil
+}
+
+func (p iamPolicyPrincipal) MarshalJSON() ([]byte, error) {
+	// Only {"*": "*"} is collapsed; {"AWS": "*"} is not equivalent to "*" in IAM role trust policies.
+	if v, ok := p["*"]; ok && len(p) == 1 && len(v) == 1 && v[0] == "*" {
+		return json.Marshal("*")
+	}
+
+	return json.Marshal(map[string]iamPolicyStrings(p))
+}
+
+// unmarshalIAMPolicyJSON decodes JSON, rejecting unknown policy elements and preserving numbers.
+func unmarshalIAMPolicyJSON(b []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	dec.UseNumber()
+
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+
+	if dec.More() {
+		return errors.New("unexpected data after JSON value")
+	}
+
+	return nil
+}
+
+func decodeIAMPolicy(s string) (*iamPolicyDocument, error) {
+	doc := &iamPolicyDocument{}
+
+	if strings.TrimSpace(s) == "" {
+		return doc, nil
+	}
+
+	if err := unmarshalIAMPolicyJSON([]byte(s), doc); err != nil {
+		return nil, fmt.Errorf("invalid policy document: %w", err)
+	}
+
+	return doc, nil
+}
+
+func (d *iamPolicyDocument) merge(newDoc *iamPolicyDocument) {
+	// Adopt the new document's Id.
+	if newDoc.Id != "" {
+		d.Id = newDoc.Id
+	}
+
+	// Let the new document upgrade the Version.
+	if newDoc.Version > d.Version {
+		d.Version = newDoc.Version
+	}
+
+	// Merge in the new document's statements, overwriting any with the same Sid.
+	for _, statement := range newDoc.Statement {
+		if statement.Sid != "" {
+			if i := slices.IndexFunc(d.Statement, func(v *iamPolicyStatement) bool {
+				return v.Sid == statement.Sid
+			}); i >= 0 {
+				d.Statement[i] = statement
+				continue
+			}
+		}
+
+		d.Statement = append(d.Statement, statement)
+	}
+}
+
+func (d *iamPolicyDocument) canonicalString() (string, error) {
+	type keyedStatement struct {
+		key       string
+		statement *iamPolicyStatement
+	}
+
+	statements := make([]keyedStatement, 0, len(d.Statement))
+	for _, statement := range d.Statement {
+		statement.canonicalize()
+
+		b, err := json.Marshal(statement)
+		if err != nil {
+			return "", fmt.Errorf("encoding policy statement: %w", err)
+		}
+
+		statements = append(statements, keyedStatement{key: string(b), statement: statement})
+	}
+
+	slices.SortStableFunc(statements, func(a, b keyedStatement) int {
+		return strings.Compare(a.key, b.key)
+	})
+
+	d.Statement = make(iamPolicyStatements, 0, len(statements))
+	for _, v := range statements {
+		d.Statement = append(d.Statement, v.statement)
+	}
+
+	b, err := json.Marshal(d)
+	if err != nil {
+		return "", fmt.Errorf("encoding policy document: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// canonicalize sorts and de-duplicates the list elements of a statement in place.
+func (s *iamPolicyStatement) canonicalize() {
+	s.Action = s.Action.canonicalize()
+	s.NotAction = s.NotAction.canonicalize()
+	s.Resource = s.Resource.canonicalize()
+	s.NotResource = s.NotResource.canonicalize()
+
+	for k, v := range s.Principal {
+		s.Principal[k] = v.canonicalize()
+	}
+	for k, v := range s.NotPrincipal {
+		s.NotPrincipal[k] = v.canonicalize()
+	}
+
+	for _, condition := range s.Condition {
+		for k, v := range condition {
+			condition[k] = v.canonicalize()
+		}
+	}
+}
+
+func (s iamPolicyStrings) canonicalize() iamPolicyStrings {
+	s = slices.Clone(s)
+	slices.Sort(s)
+
+	return slices.Compact(s)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"testing"
+)
+
+func TestPolicyNormalize(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Name:     "empty",
+			Input:    "",
+			Expected: `{}`,
+		},
+		{
+			Name:     "statementOrderAndActions",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Deny","Action":["ec2:*"],"Resource":"*"},{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject","s3:PutObject"],"Resource":["*"]}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"},{"Sid":"A","Effect":"Deny","Action":"ec2:*","Resource":"*"}]}`,
+		},
+		{
+			Name:     "singleStatementObject",
+			Input:    `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		{
+			Name:     "principalWildcard",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":{"*":["*"]}},{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Principal":{"AWS":"*"}}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":"*"},{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Principal":{"AWS":"*"}}]}`,
+		},
+		{
+			Name:     "conditionValueOrder",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:SourceAccount":["222222222222","111111111111","222222222222"]}}}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:SourceAccount":["111111111111","222222222222"]}}}]}`,
+		},
+		{
+			Name:     "conditionBoolList",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":[false]}}}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"false"}}}]}`,
+		},
+		{
+			Name:     "conditionNumberList",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"NumericLessThan":{"s3:max-keys":[10,1.50]}}}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"NumericLessThan":{"s3:max-keys":["1.5","10"]}}}]}`,
+		},
+		{
+			Name:  "conditionObjectValue",
+			Input: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"StringEquals":{"s3:prefix":[{"a":"b"}]}}}]}`,
+			Error: true,
+		},
+		{
+			Name:  "nullStatement",
+			Input: `{"Version":"2012-10-17","Statement":[null]}`,
+			Error: true,
+		},
+		{
+			Name:  "unknownElement",
+			Input: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Actions":"s3:GetObject","Resource":"*"}]}`,
+			Error: true,
+		},
+		{
+			Name:  "badJSON",
+			Input: `{"Version":"2012-10-17",`,
+			Error: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := PolicyNormalize(tc.Input)
+
+			if tc.Error {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+			} else {
+				if err != nil {
+					t.Errorf("expected no error, got: %s", err)
+				}
+			}
+
+			if p != tc.Expected {
+				t.Errorf("expected %s, got: %s", tc.Expected, p)
+			}
+		})
+	}
+}
+
+func TestPolicyMerge(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Input    []string
+		Expected string
+		Error    bool
+	}{
+		{
+			Name:     "none",
+			Expected: `{}`,
+		},
+		{
+			Name: "sidOverride",
+			Input: []string{
+				`{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+				`{"Id":"merged","Statement":{"Sid":"A","Effect":"Deny","Action":"s3:GetObject","Resource":"*"}}`,
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:*","Resource":"*"}]}`,
+			},
+			Expected: `{"Version":"2012-10-17","Id":"merged","Statement":[{"Effect":"Allow","Action":"sqs:*","Resource":"*"},{"Sid":"A","Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		{
+			Name: "nullStatement",
+			Input: []string{
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:*","Resource":"*"}]}`,
+				`{"Version":"2012-10-17","Statement":[null]}`,
+			},
+			Error: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := PolicyMerge(tc.Input...)
+
+			if tc.Error {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+			} else {
+				if err != nil {
+					t.Errorf("expected no error, got: %s", err)
+				}
+			}
+
+			if p != tc.Expected {
+				t.Errorf("expected %s, got: %s", tc.Expected, p)
+			}
+		})
+	}
+}
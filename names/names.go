@@ -24,6 +24,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names/data"
 )
 
+// TopLevelRegionAttributeDescription is the description of the top-level `region` attribute
+// injected into the schemas of regional resources and data sources.
+const TopLevelRegionAttributeDescription = `The AWS Region to use for API operations. Overrides the Region set in the provider configuration.`
+
 // Endpoint constants defined by the AWS SDK v1 but not defined in the AWS SDK v2.
 const (
 	ACMPCAEndpointID                       = "acm-pca"